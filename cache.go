@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+)
+
+// openCache opens the service details cache in the user's cache directory.
+func openCache() (*api.Cache, error) {
+	dir, err := api.DefaultCacheDir()
+	if err != nil {
+		return nil, err
+	}
+	return api.OpenCache(dir)
+}
+
+// runCache handles rtt-cli cache clear|stats and returns the process exit code.
+func runCache(action string) int {
+	cache, err := openCache()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitError
+	}
+
+	switch action {
+	case "clear":
+		if err := cache.Clear(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitError
+		}
+		fmt.Println("✓ Cache cleared.")
+	case "stats":
+		stats, err := cache.Stats(time.Now())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitError
+		}
+		fmt.Printf("Cache directory: %s\n", stats.Dir)
+		fmt.Printf("Services cached: %d (%d fresh)\n", stats.Entries, stats.Fresh)
+		fmt.Printf("Size: %s\n", formatBytes(stats.Bytes))
+	default:
+		fmt.Fprintln(os.Stderr, "Usage: rtt-cli cache clear|stats")
+		return exitUsage
+	}
+	return exitOK
+}
+
+func formatBytes(n int64) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	}
+	return fmt.Sprintf("%d bytes", n)
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+	"github.com/baz-sh/rtt-cli/internal/config"
+	"github.com/baz-sh/rtt-cli/internal/recording"
+	"github.com/baz-sh/rtt-cli/internal/stations"
+)
+
+// clientOptions turns the connection settings from the config file and
+// environment into API client options. Traffic is saved to recordDir if it is
+// set, or served from replay instead of the network if that is not nil.
+func clientOptions(cfg *config.Config, recordDir string, replay *recording.Replayer) ([]api.Option, error) {
+	if replay != nil {
+		return []api.Option{api.WithTransport(replay), api.WithClock(replay.Now)}, nil
+	}
+
+	var opts []api.Option
+
+	if cfg.BaseURL != "" {
+		opts = append(opts, api.WithBaseURL(cfg.BaseURL))
+	}
+	if cfg.Timeout != "" {
+		timeout, err := time.ParseDuration(cfg.Timeout)
+		if err != nil || timeout <= 0 {
+			return nil, fmt.Errorf("invalid timeout %q: use a duration such as 30s", cfg.Timeout)
+		}
+		opts = append(opts, api.WithTimeout(timeout))
+	}
+	if cfg.UserAgent != "" {
+		opts = append(opts, api.WithUserAgent(cfg.UserAgent))
+	}
+	if cfg.Concurrency != 0 {
+		if cfg.Concurrency < 1 {
+			return nil, fmt.Errorf("invalid concurrency %d: must be at least 1", cfg.Concurrency)
+		}
+		opts = append(opts, api.WithConcurrency(cfg.Concurrency))
+	}
+
+	var transport http.RoundTripper
+	if cfg.CAFile != "" {
+		var err error
+		transport, err = transportWithCA(cfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if recordDir != "" {
+		recorder, err := recording.NewRecorder(recordDir, transport)
+		if err != nil {
+			return nil, err
+		}
+		transport = recorder
+	}
+	if transport != nil {
+		opts = append(opts, api.WithTransport(transport))
+	}
+
+	return opts, nil
+}
+
+// transportWithCA returns the default transport, which honours HTTPS_PROXY,
+// additionally trusting the certificates in a PEM file.
+func transportWithCA(path string) (http.RoundTripper, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA file: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in CA file %s", path)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	return transport, nil
+}
+
+// stationGroups returns the member stations of every station group, for the
+// API client to search across.
+func stationGroups() map[string][]string {
+	groups := make(map[string][]string)
+	for _, g := range stations.Groups() {
+		groups[g.Code] = g.Members
+	}
+	return groups
+}
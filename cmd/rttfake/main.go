@@ -0,0 +1,43 @@
+// Command rttfake serves the fake Realtime Trains API on a local port, for
+// working on rtt-cli without network access or an API token:
+//
+//	go run ./cmd/rttfake
+//	RTT_BASE_URL=http://localhost:8080 rtt-cli EUS MAN
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/baz-sh/rtt-cli/internal/rttfake"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:8080", "address to listen on")
+	token := flag.String("token", "", "refresh token to accept (default: any)")
+	delay := flag.Duration("delay", 0, "delay every service lookup by this long")
+	tokenLifetime := flag.Duration("token-lifetime", rttfake.DefaultTokenLifetime, "how long access tokens stay valid")
+	noShift := flag.Bool("no-shift", false, "serve fixtures on their recorded date instead of starting from now")
+	flag.Parse()
+
+	fake, err := rttfake.NewWithFixtures(*token)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if !*noShift {
+		fake.ShiftTo(time.Now().Add(5 * time.Minute).Truncate(time.Minute))
+	}
+	fake.Delay(rttfake.PathService, *delay)
+	fake.SetTokenLifetime(*tokenLifetime)
+
+	fmt.Fprintf(os.Stderr, "Serving fake RTT API on http://%s\n", *addr)
+	fmt.Fprintf(os.Stderr, "Try: RTT_BASE_URL=http://%s rtt-cli EUS MAN\n", *addr)
+	if err := http.ListenAndServe(*addr, fake); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,140 @@
+package api
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+)
+
+// boardWindow is how far ahead a station board looks for trains.
+const boardWindow = 2 * time.Hour
+
+// Direction selects which side of a station board to fetch.
+type Direction int
+
+const (
+	Departures Direction = iota
+	Arrivals
+)
+
+func (d Direction) String() string {
+	if d == Arrivals {
+		return "arrivals"
+	}
+	return "departures"
+}
+
+// BoardEntry is a single train on a station departures or arrivals board.
+type BoardEntry struct {
+	ServiceID          string
+	ScheduledTime      time.Time // booked departure (or arrival) at the station
+	ExpectedTime       time.Time // realtime departure (or arrival), zero if unknown
+	LateMinutes        int
+	Platform           string
+	Origin             string
+	Destination        string
+	Operator           string
+	Cancelled          bool
+	CancellationReason string
+}
+
+// BoardResult is a station board, along with any services on it whose details
+// could not be loaded.
+type BoardResult struct {
+	Entries []BoardEntry
+	Skipped []ServiceError
+}
+
+// GetBoard returns the trains departing from, or arriving at, a station over
+// the next two hours, like a station departure screen.
+func (c *Client) GetBoard(ctx context.Context, code string, direction Direction) (BoardResult, error) {
+	now := c.now()
+	code = strings.ToUpper(code)
+
+	services, err := c.fetchServices(ctx, code, "", now, boardWindow)
+	if err != nil {
+		return BoardResult{}, err
+	}
+
+	results := make([]*BoardEntry, len(services))
+	skipped, err := c.forEachService(ctx, services, func(idx int, s serviceInfo, svcResp *serviceResponse) {
+		results[idx] = buildBoardEntry(svcResp, code, direction)
+	})
+	if err != nil {
+		return BoardResult{}, err
+	}
+
+	now = c.now()
+	var board []BoardEntry
+	for _, entry := range results {
+		if entry == nil {
+			continue
+		}
+		leaving := entry.ScheduledTime
+		if !entry.ExpectedTime.IsZero() && !entry.Cancelled {
+			leaving = entry.ExpectedTime
+		}
+		if !leaving.Before(now) {
+			board = append(board, *entry)
+		}
+	}
+
+	sort.Slice(board, func(i, j int) bool {
+		return board[i].ScheduledTime.Before(board[j].ScheduledTime)
+	})
+
+	return BoardResult{Entries: board, Skipped: skipped}, nil
+}
+
+// buildBoardEntry describes a service as seen from the given station. Returns
+// nil if the service does not depart from (or arrive at) the station.
+func buildBoardEntry(svcResp *serviceResponse, code string, direction Direction) *BoardEntry {
+	loc := findLocation(svcResp.Service.Locations, code)
+	if loc == nil {
+		return nil
+	}
+
+	td := loc.TemporalData.Departure
+	if direction == Arrivals {
+		td = loc.TemporalData.Arrival
+	}
+	if td == nil {
+		return nil
+	}
+
+	scheduled := parseAPITime(td.ScheduleAdvertised)
+	if scheduled.IsZero() {
+		return nil
+	}
+	expected := expectedTime(td)
+
+	lateMinutes := 0
+	if !expected.IsZero() {
+		lateMinutes = int(expected.Sub(scheduled).Round(time.Minute).Minutes())
+	}
+
+	platform := ""
+	if loc.LocationMetadata.Platform != nil {
+		platform = bestPlatform(loc.LocationMetadata.Platform)
+	}
+
+	reason := ""
+	if td.IsCancelled {
+		reason = cancellationReason(svcResp.Service.Reasons)
+	}
+
+	detail := buildServiceDetail(svcResp)
+	return &BoardEntry{
+		ServiceID:          detail.ID,
+		ScheduledTime:      scheduled,
+		ExpectedTime:       expected,
+		LateMinutes:        lateMinutes,
+		Platform:           platform,
+		Origin:             detail.Origin().Name,
+		Destination:        detail.Destination().Name,
+		Operator:           detail.Operator,
+		Cancelled:          td.IsCancelled,
+		CancellationReason: reason,
+	}
+}
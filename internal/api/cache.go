@@ -0,0 +1,284 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// How long cached service details stay fresh. A service payload mixes the
+// timetable, which rarely changes, with realtime forecasts, which change by
+// the minute, and the API only returns them together. So each cached copy has
+// two lifetimes: a long one for its timetable, and one for its realtime data
+// set by how much that can still change: a little while a train is running or
+// about to, more for trains later in the day, and most once the journey is
+// over.
+const (
+	cacheTTLTimetable = 24 * time.Hour
+	cacheTTLRunning   = time.Minute      // running, or due to start within runningHorizon
+	cacheTTLUpcoming  = 30 * time.Minute // starts later than runningHorizon
+	cacheTTLFinished  = 24 * time.Hour   // finished over finishedAfter ago
+	runningHorizon    = 2 * time.Hour
+	finishedAfter     = time.Hour
+)
+
+// maxCacheBytes caps the size of the cache on disk. The oldest entries are
+// removed first once it is exceeded.
+const maxCacheBytes = 100 << 20
+
+// Cache stores service details on disk, keyed by unique service identity, so
+// repeated searches don't download every train's schedule again. It is safe
+// for concurrent use; failures to read or write it are treated as misses.
+type Cache struct {
+	dir string
+}
+
+// cacheEntry is the on-disk form of a cached service.
+type cacheEntry struct {
+	FetchedAt          time.Time       `json:"fetched_at"`
+	ExpiresAt          time.Time       `json:"expires_at"` // when the realtime data goes stale
+	TimetableExpiresAt time.Time       `json:"timetable_expires_at,omitzero"`
+	Body               json.RawMessage `json:"body"`
+}
+
+// timetableExpiry returns when the entry's timetable goes stale. Entries
+// written before timetables were kept longer expire all at once.
+func (e cacheEntry) timetableExpiry() time.Time {
+	if e.TimetableExpiresAt.IsZero() {
+		return e.ExpiresAt
+	}
+	return e.TimetableExpiresAt
+}
+
+// CacheStats summarises the contents of a cache.
+type CacheStats struct {
+	Dir     string
+	Entries int
+	Fresh   int   // entries whose realtime data has not gone stale
+	Bytes   int64 // total size on disk
+}
+
+// DefaultCacheDir returns the directory used for the cache under the user's
+// cache directory, e.g. ~/.cache/rtt-cli/services on Linux.
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find cache directory: %w", err)
+	}
+	return filepath.Join(dir, "rtt-cli", "services"), nil
+}
+
+// OpenCache opens the cache in dir, creating it if needed, and removes
+// entries that have expired.
+func OpenCache(dir string) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	c := &Cache{dir: dir}
+	c.prune(time.Now())
+	return c, nil
+}
+
+// prune removes entries old enough that even their timetable has expired,
+// along with temporary files left by interrupted writes, and then the oldest
+// entries while the cache is over maxCacheBytes. Entries are judged by when
+// their file was written, so none need to be read.
+func (c *Cache) prune(now time.Time) {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+
+	type file struct {
+		path    string
+		size    int64
+		written time.Time
+	}
+	var kept []file
+	var total int64
+	for _, e := range entries {
+		ext := filepath.Ext(e.Name())
+		if e.IsDir() || (ext != ".json" && ext != ".tmp") {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		f := file{filepath.Join(c.dir, e.Name()), info.Size(), info.ModTime()}
+		maxAge := max(cacheTTLTimetable, cacheTTLFinished)
+		if ext == ".tmp" {
+			maxAge = time.Hour
+		}
+		if now.Sub(f.written) > maxAge {
+			os.Remove(f.path)
+			continue
+		}
+		if ext == ".json" {
+			kept = append(kept, f)
+			total += f.size
+		}
+	}
+
+	if total <= maxCacheBytes {
+		return
+	}
+	slices.SortFunc(kept, func(a, b file) int { return a.written.Compare(b.written) })
+	for _, f := range kept {
+		if total <= maxCacheBytes {
+			break
+		}
+		if os.Remove(f.path) == nil {
+			total -= f.size
+		}
+	}
+}
+
+// Clear removes every cached service.
+func (c *Cache) Clear() error {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read cache: %w", err)
+	}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		if err := os.Remove(filepath.Join(c.dir, e.Name())); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to clear cache: %w", err)
+		}
+	}
+	return nil
+}
+
+// Stats counts the cached services, and how many are still fresh at now.
+func (c *Cache) Stats(now time.Time) (CacheStats, error) {
+	stats := CacheStats{Dir: c.dir}
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return stats, fmt.Errorf("failed to read cache: %w", err)
+	}
+	for _, e := range entries {
+		if filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		stats.Entries++
+		stats.Bytes += info.Size()
+		if entry, ok := c.read(filepath.Join(c.dir, e.Name())); ok && now.Before(entry.ExpiresAt) {
+			stats.Fresh++
+		}
+	}
+	return stats, nil
+}
+
+// get returns the cached body of a service if its timetable is still fresh
+// at now. live reports whether its realtime data is still fresh too.
+func (c *Cache) get(uniqueIdentity string, now time.Time) (body json.RawMessage, live, ok bool) {
+	entry, ok := c.read(c.path(uniqueIdentity))
+	if !ok || !now.Before(entry.timetableExpiry()) {
+		return nil, false, false
+	}
+	return entry.Body, now.Before(entry.ExpiresAt), true
+}
+
+// put stores the body of a service. Its realtime data is fresh until now plus
+// ttl, and its timetable for at least cacheTTLTimetable.
+func (c *Cache) put(uniqueIdentity string, body json.RawMessage, now time.Time, ttl time.Duration) {
+	data, err := json.Marshal(cacheEntry{
+		FetchedAt:          now,
+		ExpiresAt:          now.Add(ttl),
+		TimetableExpiresAt: now.Add(max(ttl, cacheTTLTimetable)),
+		Body:               body,
+	})
+	if err != nil {
+		return
+	}
+	// Write to a temporary file and rename it, so concurrent readers never see
+	// a partial entry
+	tmp, err := os.CreateTemp(c.dir, "*.tmp")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), c.path(uniqueIdentity)); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+func (c *Cache) read(path string) (cacheEntry, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cacheEntry{}, false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return cacheEntry{}, false
+	}
+	return entry, true
+}
+
+// path returns the file a service is cached in. Colons are replaced because
+// they aren't allowed in file names everywhere.
+func (c *Cache) path(uniqueIdentity string) string {
+	name := strings.NewReplacer(":", "_", "/", "_", `\`, "_").Replace(uniqueIdentity)
+	return filepath.Join(c.dir, name+".json")
+}
+
+// serviceTTL returns how long a service's details stay fresh, given when it
+// starts and finishes.
+func serviceTTL(svcResp *serviceResponse, now time.Time) time.Duration {
+	var first, last time.Time
+	for _, loc := range svcResp.Service.Locations {
+		for _, td := range []*temporalData{loc.TemporalData.Arrival, loc.TemporalData.Departure} {
+			if td == nil {
+				continue
+			}
+			scheduled := parseAPITime(td.ScheduleAdvertised)
+			if scheduled.IsZero() {
+				continue
+			}
+			if first.IsZero() || scheduled.Before(first) {
+				first = scheduled
+			}
+			// A late train finishes when it is expected to, not when booked
+			finished := scheduled
+			for _, rt := range []string{td.RealtimeForecast, td.RealtimeActual} {
+				if t := parseAPITime(rt); t.After(finished) {
+					finished = t
+				}
+			}
+			if finished.After(last) {
+				last = finished
+			}
+		}
+	}
+
+	switch {
+	case first.IsZero():
+		return cacheTTLRunning
+	case now.Sub(last) > finishedAfter:
+		return cacheTTLFinished
+	case first.Sub(now) > runningHorizon:
+		// Expire by the time the train comes within the horizon, when its
+		// forecasts start to matter
+		return max(cacheTTLRunning, min(cacheTTLUpcoming, first.Sub(now)-runningHorizon))
+	}
+	return cacheTTLRunning
+}
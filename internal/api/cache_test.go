@@ -0,0 +1,129 @@
+package api_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+	"github.com/baz-sh/rtt-cli/internal/rttfake"
+)
+
+// testClock is a clock for the client that tests can move forward.
+type testClock struct {
+	mu     sync.Mutex
+	offset time.Duration
+}
+
+func (c *testClock) now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return time.Now().Add(c.offset)
+}
+
+func (c *testClock) advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.offset += d
+}
+
+func newCachedTestClient(t *testing.T, firstDeparture time.Duration) (*rttfake.Fake, *api.Client, *testClock) {
+	t.Helper()
+	cache, err := api.OpenCache(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	clock := &testClock{}
+	fake, client := newTestClient(t, api.WithCache(cache), api.WithClock(clock.now))
+	fake.ShiftTo(time.Now().Add(firstDeparture).Truncate(time.Minute))
+	return fake, client, clock
+}
+
+func search(t *testing.T, client *api.Client) api.DepartureResult {
+	t.Helper()
+	result, err := client.GetDepartures(context.Background(), "EUS", "MAN", api.SearchOptions{})
+	if err != nil {
+		t.Fatalf("GetDepartures: %v", err)
+	}
+	if len(result.Departures) != 4 {
+		t.Fatalf("got %d departures, want 4", len(result.Departures))
+	}
+	return result
+}
+
+func TestCacheRefetchesRunningTrains(t *testing.T) {
+	fake, client, clock := newCachedTestClient(t, 5*time.Minute)
+
+	search(t, client)
+	search(t, client)
+	if got := fake.Requests(rttfake.PathService); got != 4 {
+		t.Fatalf("service requests = %d after a repeated search, want 4", got)
+	}
+
+	clock.advance(2 * time.Minute)
+	search(t, client)
+	if got := fake.Requests(rttfake.PathService); got != 8 {
+		t.Errorf("service requests = %d once realtime data went stale, want 8", got)
+	}
+}
+
+func TestCacheKeepsTimetablesOfLaterTrains(t *testing.T) {
+	fake, client, clock := newCachedTestClient(t, 3*time.Hour)
+
+	search(t, client)
+	clock.advance(45 * time.Minute)
+	result := search(t, client)
+
+	// Only the cancelled train is fetched again, for its reason
+	if got := fake.Requests(rttfake.PathService); got != 5 {
+		t.Errorf("service requests = %d, want 5", got)
+	}
+	for _, dep := range result.Departures {
+		switch dep.ServiceID {
+		case cancelled:
+			if dep.CancellationReason == "" {
+				t.Errorf("cancelled train lost its reason")
+			}
+		case delayed:
+			if dep.LateMinutes != 7 {
+				t.Errorf("delayed train LateMinutes = %d, want 7 from the search results", dep.LateMinutes)
+			}
+			if !dep.ExpectedArrival.IsZero() {
+				t.Errorf("delayed train kept a stale expected arrival of %v", dep.ExpectedArrival)
+			}
+		}
+	}
+}
+
+func TestOpenCachePrunesExpiredEntries(t *testing.T) {
+	dir := t.TempDir()
+	old := time.Now().Add(-25 * time.Hour)
+	files := map[string]time.Time{
+		"old.json":   old,
+		"fresh.json": time.Now(),
+		"stale.tmp":  time.Now().Add(-2 * time.Hour),
+		"notes.txt":  old,
+	}
+	for name, modTime := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte("{}"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := api.OpenCache(dir); err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]bool{"old.json": false, "fresh.json": true, "stale.tmp": false, "notes.txt": true} {
+		_, err := os.Stat(filepath.Join(dir, name))
+		if got := err == nil; got != want {
+			t.Errorf("%s kept = %v, want %v", name, got, want)
+		}
+	}
+}
@@ -1,35 +1,111 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
-	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 )
 
-const baseURL = "https://data.rtt.io"
-
+// Client talks to the Realtime Trains API. Every request method takes a
+// context that bounds the whole call, including concurrent service lookups and
+// retries, so callers can cancel a search or give it an overall deadline.
 type Client struct {
 	httpClient   *http.Client
+	baseURL      string
+	userAgent    string
+	limiter      *limiter // shared by every request the client sends
 	refreshToken string
+	now          func() time.Time
+	cache        *Cache              // nil if service details aren't cached
+	groups       map[string][]string // station group codes to their members' CRS codes
+
+	// tokenMu guards the access token, which is shared by concurrent requests.
+	tokenMu      sync.Mutex
 	accessToken  string
 	tokenExpiry  time.Time
+	tokenRefresh *tokenRefresh // in-flight token exchange, nil if none
 }
 
 type Departure struct {
-	BookedDepartureTime string
-	DeparturePlatform   string
-	Platform            string
-	ArrivingAt          string
-	Duration            string
-	Leaving             string
-	Service             string
-	NextDay             bool
-	departureTime       time.Time // parsed, used for filtering/sorting
+	ServiceID             string // unique service identity, for use with GetServiceDetail
+	BookedDepartureTime   string
+	ExpectedDepartureTime string // empty when no realtime data is available
+	DeparturePlatform     string // empty when the platform is not known
+	Platform              string // arrival platform, empty when not known
+	ArrivingAt            string
+	ExpectedArrivalTime   string // empty when no realtime data is available
+	LateMinutes           int    // minutes behind (or, if negative, ahead of) the booked departure
+	Cancelled             bool
+	CancellationReason    string // empty if the cancellation has no published reason
+	Duration              string
+	Leaving               string
+	Service               string
+	CallsAt               []string  // via stations the train calls at, in calling order
+	NextDay               bool      // departs on a later day than the one searched
+	ScheduledDeparture    time.Time // booked departure, used for filtering/sorting
+	ExpectedDeparture     time.Time // realtime departure, zero if unknown
+	ScheduledArrival      time.Time // booked arrival at the destination, zero if unknown
+	ExpectedArrival       time.Time // realtime arrival at the destination, zero if unknown
+
+	// The stations the train was found running between. They only differ
+	// from the ones searched for when the search named a station group.
+	From, FromName string // CRS code and name
+	To, ToName     string
+}
+
+// DepartureResult is the outcome of a departures search. Services that were
+// found but whose details failed to load are listed in Skipped rather than
+// silently left out, so callers can warn that the list may be incomplete.
+type DepartureResult struct {
+	Departures []Departure
+	Skipped    []ServiceError
+}
+
+// ServiceError records a service whose details could not be loaded.
+type ServiceError struct {
+	ServiceID          string
+	ScheduledDeparture time.Time // booked departure from the searched station, zero if unknown
+	Operator           string
+	Err                error
+}
+
+func (e ServiceError) Error() string {
+	return fmt.Sprintf("%s %s (%s): %v", e.ScheduledDeparture.Format("15:04"), e.Operator, e.ServiceID, e.Err)
+}
+
+func (e ServiceError) Unwrap() error {
+	return e.Err
+}
+
+// HasRealtime reports whether an expected departure time is known for the service.
+func (d Departure) HasRealtime() bool {
+	return d.ExpectedDepartureTime != ""
+}
+
+// LeavingAt returns when the train is expected to leave: the realtime estimate
+// if there is one, otherwise the booked time.
+func (d Departure) LeavingAt() time.Time {
+	if !d.ExpectedDeparture.IsZero() && !d.Cancelled {
+		return d.ExpectedDeparture
+	}
+	return d.ScheduledDeparture
+}
+
+// Departed reports whether the train has left as of now.
+func (d Departure) Departed(now time.Time) bool {
+	return d.LeavingAt().Before(now)
+}
+
+// UpdateLeaving recomputes the Leaving countdown as of now.
+func (d *Departure) UpdateLeaving(now time.Time) {
+	d.Leaving = formatDuration(now, d.LeavingAt())
 }
 
 // v2 API response types
@@ -63,12 +139,21 @@ type serviceResponse struct {
 			} `json:"operator"`
 		} `json:"scheduleMetadata"`
 		Locations []serviceLocation `json:"locations"`
+		Reasons   []reasonData      `json:"reasons"`
 	} `json:"service"`
 }
 
+// reasonData is a delay or cancellation reason attached to a service.
+type reasonData struct {
+	Type      string `json:"type"` // "CANCEL" or "LATE"
+	ShortText string `json:"shortText"`
+	LongText  string `json:"longText"`
+}
+
 type serviceLocation struct {
 	Location struct {
-		ShortCodes []string `json:"shortCodes"`
+		Description string   `json:"description"`
+		ShortCodes  []string `json:"shortCodes"`
 	} `json:"location"`
 	TemporalData struct {
 		Arrival   *temporalData `json:"arrival"`
@@ -79,6 +164,23 @@ type serviceLocation struct {
 	} `json:"locationMetadata"`
 }
 
+// clearRealtime removes the realtime data from a service, leaving only its
+// timetable, for when the realtime part is too old to show.
+func (r *serviceResponse) clearRealtime() {
+	for i := range r.Service.Locations {
+		loc := &r.Service.Locations[i]
+		for _, td := range []*temporalData{loc.TemporalData.Arrival, loc.TemporalData.Departure} {
+			if td != nil {
+				*td = temporalData{ScheduleAdvertised: td.ScheduleAdvertised}
+			}
+		}
+		if p := loc.LocationMetadata.Platform; p != nil {
+			*p = plannedActualData{Planned: p.Planned}
+		}
+	}
+	r.Service.Reasons = nil
+}
+
 type temporalData struct {
 	ScheduleAdvertised string `json:"scheduleAdvertised"`
 	RealtimeForecast   string `json:"realtimeForecast"`
@@ -93,101 +195,95 @@ type plannedActualData struct {
 }
 
 type serviceInfo struct {
-	uniqueIdentity      string
-	bookedDepartureTime string
-	platform            string
-	operator            string
+	from, to              string // CRS codes of the stations searched between; to is empty for boards
+	uniqueIdentity        string
+	bookedDepartureTime   string
+	expectedDepartureTime time.Time
+	platform              string
+	operator              string
+	cancelled             bool
 }
 
-type accessTokenResponse struct {
-	Token      string `json:"token"`
-	ValidUntil string `json:"validUntil"`
-}
-
-func NewClient(refreshToken string) *Client {
-	return &Client{
-		httpClient:   &http.Client{Timeout: 10 * time.Second},
-		refreshToken: refreshToken,
+// leavingAt returns when the train is expected to leave, going by the search
+// results alone, like Departure.LeavingAt. It is zero if no time is known.
+func (s serviceInfo) leavingAt() time.Time {
+	if !s.expectedDepartureTime.IsZero() && !s.cancelled {
+		return s.expectedDepartureTime
 	}
+	return parseAPITime(s.bookedDepartureTime)
 }
 
-// ensureAccessToken exchanges the refresh token for a short-life access token if needed.
-func (c *Client) ensureAccessToken() error {
-	if c.accessToken != "" && time.Now().Before(c.tokenExpiry) {
-		return nil
+// NewClient creates a client that authenticates with the given refresh token.
+// Without options it talks to DefaultBaseURL with the package defaults.
+func NewClient(refreshToken string, opts ...Option) *Client {
+	o := clientOptions{
+		baseURL:     DefaultBaseURL,
+		userAgent:   DefaultUserAgent,
+		concurrency: DefaultConcurrency,
+		rateLimit:   DefaultRateLimit,
+		burst:       DefaultBurst,
+		now:         time.Now,
 	}
-
-	req, err := http.NewRequest("GET", baseURL+"/api/get_access_token", nil)
-	if err != nil {
-		return err
+	for _, opt := range opts {
+		opt(&o)
 	}
-	req.Header.Set("Authorization", "Bearer "+c.refreshToken)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to exchange token: %w", err)
+	if o.httpClient == nil && o.timeout == 0 {
+		o.timeout = DefaultTimeout
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("token exchange failed with status %d", resp.StatusCode)
-	}
-
-	var tokenResp accessTokenResponse
-	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
-		return fmt.Errorf("failed to decode token response: %w", err)
-	}
-
-	c.accessToken = tokenResp.Token
-	if expiry, err := time.Parse(time.RFC3339, tokenResp.ValidUntil); err == nil {
-		// Refresh a bit early to avoid edge cases
-		c.tokenExpiry = expiry.Add(-30 * time.Second)
-	} else {
-		// If we can't parse expiry, refresh after 5 minutes
-		c.tokenExpiry = time.Now().Add(5 * time.Minute)
+	return &Client{
+		httpClient:   o.buildHTTPClient(),
+		baseURL:      o.baseURL,
+		userAgent:    o.userAgent,
+		limiter:      newLimiter(o.rateLimit, o.burst, o.concurrency),
+		refreshToken: refreshToken,
+		now:          o.now,
+		cache:        o.cache,
+		groups:       o.groups,
 	}
+}
 
-	return nil
+// LimiterStatus reports whether the client is holding requests back because
+// the API has rate-limited it, and when they will resume.
+func (c *Client) LimiterStatus() LimiterStatus {
+	return c.limiter.status(time.Now())
 }
 
-func (c *Client) GetDepartures(from, to string) ([]Departure, error) {
-	now := time.Now()
-	from = strings.ToUpper(from)
-	to = strings.ToUpper(to)
+// Now returns the current time as the client sees it, which differs from the
+// system clock when the client was created WithClock.
+func (c *Client) Now() time.Time {
+	return c.now()
+}
 
-	services, err := c.fetchServices(from, to, now)
+// GetDepartures returns direct services from one station to another. By default
+// it lists trains departing from now onwards; opts can move the search to another
+// time or look for trains arriving by a deadline instead. It waits for every
+// service's details to load; use StreamDepartures to show them as they arrive.
+//
+// Either station may be a group defined with WithStationGroups, in which case
+// every member station is searched and each departure's From and To say
+// which stations it runs between.
+func (c *Client) GetDepartures(ctx context.Context, from, to string, opts SearchOptions) (DepartureResult, error) {
+	stream, err := c.StreamDepartures(ctx, from, to, opts)
 	if err != nil {
-		return nil, err
-	}
-
-	departures := c.fetchDepartureDetails(services, to)
-
-	// Filter out departed trains and sort by departure time
-	now = time.Now()
-	var result []Departure
-	for _, dep := range departures {
-		if !dep.departureTime.Before(now) {
-			result = append(result, dep)
-		}
+		return DepartureResult{}, err
 	}
-
-	sort.Slice(result, func(i, j int) bool {
-		return result[i].departureTime.Before(result[j].departureTime)
-	})
-
-	return result, nil
+	return stream.Collect(stream.Total())
 }
 
-// fetchServices returns passenger services from a station filtered by destination.
-func (c *Client) fetchServices(from, to string, now time.Time) ([]serviceInfo, error) {
+// fetchServices returns passenger services calling at a station within window of
+// timeFrom, filtered by destination unless to is empty.
+func (c *Client) fetchServices(ctx context.Context, from, to string, timeFrom time.Time, window time.Duration) ([]serviceInfo, error) {
 	params := url.Values{}
 	params.Set("code", from)
-	params.Set("filterTo", to)
-	params.Set("timeFrom", now.Format("2006-01-02T15:04:05"))
-	params.Set("timeWindow", "1439")
-	locationURL := fmt.Sprintf("%s/gb-nr/location?%s", baseURL, params.Encode())
+	if to != "" {
+		params.Set("filterTo", to)
+	}
+	params.Set("timeFrom", timeFrom.Format("2006-01-02T15:04:05"))
+	params.Set("timeWindow", strconv.Itoa(int(window.Minutes())))
+	locationURL := fmt.Sprintf("%s/gb-nr/location?%s", c.baseURL, params.Encode())
 
-	raw, err := c.fetchJSON(locationURL)
+	raw, err := c.fetchJSON(ctx, locationURL)
 	if err != nil {
 		return nil, err
 	}
@@ -207,8 +303,12 @@ func (c *Client) fetchServices(from, to string, now time.Time) ([]serviceInfo, e
 		}
 
 		depTime := ""
+		var expDepTime time.Time
+		cancelled := false
 		if svc.TemporalData.Departure != nil {
 			depTime = svc.TemporalData.Departure.ScheduleAdvertised
+			expDepTime = expectedTime(svc.TemporalData.Departure)
+			cancelled = svc.TemporalData.Departure.IsCancelled
 		}
 
 		platform := ""
@@ -217,89 +317,190 @@ func (c *Client) fetchServices(from, to string, now time.Time) ([]serviceInfo, e
 		}
 
 		services = append(services, serviceInfo{
-			uniqueIdentity:      svc.ScheduleMetadata.UniqueIdentity,
-			bookedDepartureTime: depTime,
-			platform:            platform,
-			operator:            svc.ScheduleMetadata.Operator.Name,
+			from:                  from,
+			to:                    to,
+			uniqueIdentity:        svc.ScheduleMetadata.UniqueIdentity,
+			bookedDepartureTime:   depTime,
+			expectedDepartureTime: expDepTime,
+			platform:              platform,
+			operator:              svc.ScheduleMetadata.Operator.Name,
+			cancelled:             cancelled,
 		})
 	}
 
 	return services, nil
 }
 
-// fetchDepartureDetails fetches full service details concurrently and builds departures.
-func (c *Client) fetchDepartureDetails(services []serviceInfo, to string) []Departure {
-	results := make([]*Departure, len(services))
-	sem := make(chan struct{}, 3)
+// forEachService fetches the full schedule of each service concurrently, as
+// far as the client's limiter allows, calling fn with each one that loads. fn
+// may be called from several goroutines at once. Services that fail to load
+// are returned, in search order, rather than passed to fn. The only error
+// returned is the context's, once it is cancelled or its deadline passes.
+func (c *Client) forEachService(ctx context.Context, services []serviceInfo, fn func(idx int, s serviceInfo, svcResp *serviceResponse)) ([]ServiceError, error) {
 	var wg sync.WaitGroup
+	failures := make([]error, len(services))
 
 	for i, svc := range services {
 		wg.Add(1)
 		go func(idx int, s serviceInfo) {
 			defer wg.Done()
-			sem <- struct{}{}
-			defer func() { <-sem }()
 
-			// uniqueIdentity is "gb-nr:IDENTITY:DATE"
-			parts := strings.SplitN(s.uniqueIdentity, ":", 3)
-			if len(parts) != 3 {
+			svcResp, err := c.loadService(ctx, s)
+			if err != nil {
+				failures[idx] = err
 				return
 			}
-			params := url.Values{}
-			params.Set("identity", parts[1])
-			params.Set("departureDate", parts[2])
 
-			raw, err := c.fetchJSON(fmt.Sprintf("%s/gb-nr/service?%s", baseURL, params.Encode()))
-			if err != nil || raw == nil {
-				return
-			}
+			fn(idx, s, svcResp)
+		}(i, svc)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var skipped []ServiceError
+	for i, err := range failures {
+		if err != nil {
+			skipped = append(skipped, newServiceError(services[i], err))
+		}
+	}
+	return skipped, nil
+}
+
+// loadService fetches the full schedule of a service found by a search,
+// treating a service with no details as an error. A train that isn't due
+// within runningHorizon only needs its timetable: the search results carry
+// its realtime departure, and nothing else about it is live yet. Cancelled
+// trains are the exception, as the reason is only in their realtime data.
+func (c *Client) loadService(ctx context.Context, s serviceInfo) (*serviceResponse, error) {
+	timetableOnly := !s.cancelled && s.leavingAt().Sub(c.now()) > runningHorizon
+	svcResp, err := c.fetchService(ctx, s.uniqueIdentity, timetableOnly)
+	if err == nil && svcResp == nil {
+		err = fmt.Errorf("no details available: %w", ErrNotFound)
+	}
+	return svcResp, err
+}
 
+func newServiceError(s serviceInfo, err error) ServiceError {
+	return ServiceError{
+		ServiceID:          s.uniqueIdentity,
+		ScheduledDeparture: parseAPITime(s.bookedDepartureTime),
+		Operator:           s.operator,
+		Err:                err,
+	}
+}
+
+// fetchService fetches the full schedule of a single service, from the cache
+// if it holds a fresh copy. With timetableOnly, a copy whose realtime data has
+// gone stale will do, with that data removed. Returns nil, nil if the API has
+// no data for the service.
+func (c *Client) fetchService(ctx context.Context, uniqueIdentity string, timetableOnly bool) (*serviceResponse, error) {
+	if c.cache != nil {
+		if raw, live, ok := c.cache.get(uniqueIdentity, c.now()); ok && (live || timetableOnly) {
 			var svcResp serviceResponse
-			if err := json.Unmarshal(raw, &svcResp); err != nil {
-				return
+			if err := json.Unmarshal(raw, &svcResp); err == nil {
+				if !live {
+					svcResp.clearRealtime()
+				}
+				return &svcResp, nil
 			}
+		}
+	}
 
-			results[idx] = buildDeparture(&svcResp, to, s)
-		}(i, svc)
+	// uniqueIdentity is "gb-nr:IDENTITY:DATE"
+	parts := strings.SplitN(uniqueIdentity, ":", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid service identity %q", uniqueIdentity)
 	}
-	wg.Wait()
+	params := url.Values{}
+	params.Set("identity", parts[1])
+	params.Set("departureDate", parts[2])
 
-	var departures []Departure
-	for _, dep := range results {
-		if dep != nil {
-			departures = append(departures, *dep)
-		}
+	raw, err := c.fetchJSON(ctx, fmt.Sprintf("%s/gb-nr/service?%s", c.baseURL, params.Encode()))
+	if err != nil || raw == nil {
+		return nil, err
+	}
+
+	var svcResp serviceResponse
+	if err := json.Unmarshal(raw, &svcResp); err != nil {
+		return nil, fmt.Errorf("failed to decode service response: %w", err)
+	}
+	if c.cache != nil {
+		now := c.now()
+		c.cache.put(uniqueIdentity, raw, now, serviceTTL(&svcResp, now))
 	}
-	return departures
+	return &svcResp, nil
 }
 
 // fetchJSON makes an authenticated GET request and returns the raw response body.
-// Returns nil, nil for 204 (no content) responses. Retries on rate limiting.
-func (c *Client) fetchJSON(rawURL string) (json.RawMessage, error) {
-	if err := c.ensureAccessToken(); err != nil {
+// Returns nil, nil for 204 (no content) responses. Requests are paced by the
+// client's limiter; rate-limited ones are retried after the wait the API asks
+// for, or a growing backoff, and ones rejected for their token are retried once
+// with a fresh one.
+func (c *Client) fetchJSON(ctx context.Context, rawURL string) (json.RawMessage, error) {
+	token, err := c.token(ctx, "")
+	if err != nil {
 		return nil, err
 	}
 
-	for attempt := range 3 {
-		raw, err := c.doGet(rawURL)
-		if err == errRateLimited {
-			time.Sleep(time.Duration(attempt+1) * time.Second)
+	refreshed := false
+	for attempt := 1; ; attempt++ {
+		raw, err := c.limitedGet(ctx, rawURL, token)
+		if errors.Is(err, ErrUnauthorized) && !refreshed {
+			refreshed = true
+			if token, err = c.token(ctx, token); err != nil {
+				return nil, err
+			}
+			raw, err = c.limitedGet(ctx, rawURL, token)
+		}
+		if errors.Is(err, ErrRateLimited) && attempt < maxAttempts {
 			continue
 		}
 		return raw, err
 	}
+}
 
-	return nil, fmt.Errorf("API rate limited after retries")
+// limitedGet sends a request once the limiter allows it, and reports
+// rate-limited responses back to the limiter so every caller slows down.
+func (c *Client) limitedGet(ctx context.Context, rawURL, token string) (json.RawMessage, error) {
+	release, err := c.limiter.wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	raw, err := c.doGet(ctx, rawURL, token)
+	var statusErr *StatusError
+	switch {
+	case errors.As(err, &statusErr) && errors.Is(err, ErrRateLimited):
+		c.limiter.throttle(statusErr.RetryAfter, time.Now())
+	case err == nil:
+		c.limiter.succeeded()
+	}
+	return raw, err
 }
 
-var errRateLimited = fmt.Errorf("rate limited")
+// sleep waits for d, returning early with the context's error if it is
+// cancelled first.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
 
-func (c *Client) doGet(rawURL string) (json.RawMessage, error) {
-	req, err := http.NewRequest("GET", rawURL, nil)
+func (c *Client) doGet(ctx context.Context, rawURL, token string) (json.RawMessage, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", rawURL, nil)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("User-Agent", c.userAgent)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -307,16 +508,12 @@ func (c *Client) doGet(rawURL string) (json.RawMessage, error) {
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusTooManyRequests {
-		return nil, errRateLimited
-	}
-
 	if resp.StatusCode == http.StatusNoContent {
 		return nil, nil
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
+		return nil, newStatusError(resp)
 	}
 
 	var raw json.RawMessage
@@ -338,20 +535,36 @@ func bestPlatform(p *plannedActualData) string {
 	return p.Planned
 }
 
-func buildDeparture(svcResp *serviceResponse, to string, info serviceInfo) *Departure {
+// buildDeparture builds a departure to the given destination. ref is the time
+// being searched for, which decides whether the train runs on the next day, and
+// now is the current time, which the countdown to departure is measured from.
+func buildDeparture(svcResp *serviceResponse, to string, info serviceInfo, ref, now time.Time) *Departure {
 	depTime := parseAPITime(info.bookedDepartureTime)
 	if depTime.IsZero() {
 		return nil
 	}
+	expDepTime := info.expectedDepartureTime
 
 	arrLoc := findLocation(svcResp.Service.Locations, to)
 	if arrLoc == nil {
 		return nil
 	}
+	fromName := info.from
+	if depLoc := findLocation(svcResp.Service.Locations, info.from); depLoc != nil {
+		fromName = locationName(depLoc)
+	}
 
-	var arrTime time.Time
+	var arrTime, expArrTime time.Time
+	cancelled := info.cancelled
 	if arrLoc.TemporalData.Arrival != nil {
 		arrTime = parseAPITime(arrLoc.TemporalData.Arrival.ScheduleAdvertised)
+		expArrTime = expectedTime(arrLoc.TemporalData.Arrival)
+		cancelled = cancelled || arrLoc.TemporalData.Arrival.IsCancelled
+	}
+
+	reason := ""
+	if cancelled {
+		reason = cancellationReason(svcResp.Service.Reasons)
 	}
 
 	arrPlatform := ""
@@ -359,48 +572,100 @@ func buildDeparture(svcResp *serviceResponse, to string, info serviceInfo) *Depa
 		arrPlatform = bestPlatform(arrLoc.LocationMetadata.Platform)
 	}
 
-	depPlatform := info.platform
-	if depPlatform == "" {
-		depPlatform = "1"
-	}
-	if arrPlatform == "" {
-		arrPlatform = "1"
-	}
-
-	now := time.Now()
-	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
-	depDay := time.Date(depTime.Year(), depTime.Month(), depTime.Day(), 0, 0, 0, 0, depTime.Location())
-	nextDay := depDay.After(today)
+	nextDay := startOfDay(depTime).After(startOfDay(ref))
 
 	timeStr := depTime.Format("15:04")
-	if nextDay {
+	if nextDay || !startOfDay(depTime).Equal(startOfDay(now)) {
 		timeStr = depTime.Format("15:04 (Mon)")
 	}
 
+	// Count down to when the train is actually expected to leave
+	leavingTime := depTime
+	expDepStr := ""
+	lateMinutes := 0
+	if !expDepTime.IsZero() {
+		leavingTime = expDepTime
+		expDepStr = expDepTime.Format("15:04")
+		lateMinutes = int(expDepTime.Sub(depTime).Round(time.Minute).Minutes())
+	}
+
+	expArrStr := ""
+	if !expArrTime.IsZero() {
+		expArrStr = expArrTime.Format("15:04")
+	}
+
 	return &Departure{
-		BookedDepartureTime: timeStr,
-		DeparturePlatform:   depPlatform,
-		Platform:            arrPlatform,
-		ArrivingAt:          arrTime.Format("15:04"),
-		Duration:            formatDuration(depTime, arrTime),
-		Leaving:             formatDuration(now, depTime),
-		Service:             info.operator,
-		NextDay:             nextDay,
-		departureTime:       depTime,
+		ServiceID:             info.uniqueIdentity,
+		BookedDepartureTime:   timeStr,
+		ExpectedDepartureTime: expDepStr,
+		DeparturePlatform:     info.platform,
+		Platform:              arrPlatform,
+		ArrivingAt:            arrTime.Format("15:04"),
+		ExpectedArrivalTime:   expArrStr,
+		LateMinutes:           lateMinutes,
+		Cancelled:             cancelled,
+		CancellationReason:    reason,
+		Duration:              formatDuration(depTime, arrTime),
+		Leaving:               formatDuration(now, leavingTime),
+		Service:               info.operator,
+		NextDay:               nextDay,
+		ScheduledDeparture:    depTime,
+		ExpectedDeparture:     expDepTime,
+		ScheduledArrival:      arrTime,
+		ExpectedArrival:       expArrTime,
+		From:                  info.from,
+		FromName:              fromName,
+		To:                    to,
+		ToName:                locationName(arrLoc),
 	}
 }
 
+// members returns the CRS codes of the stations a search from or to code
+// covers: the members of a station group, or otherwise just code.
+func (c *Client) members(code string) []string {
+	if members, ok := c.groups[code]; ok {
+		return members
+	}
+	return []string{code}
+}
+
+// cancellationReason returns the text of the first cancellation reason, if any.
+func cancellationReason(reasons []reasonData) string {
+	for _, r := range reasons {
+		if r.Type != "CANCEL" {
+			continue
+		}
+		if r.LongText != "" {
+			return r.LongText
+		}
+		return r.ShortText
+	}
+	return ""
+}
+
 func findLocation(locations []serviceLocation, code string) *serviceLocation {
 	for i := range locations {
-		for _, sc := range locations[i].Location.ShortCodes {
-			if strings.EqualFold(sc, code) {
-				return &locations[i]
-			}
+		if hasShortCode(&locations[i], code) {
+			return &locations[i]
 		}
 	}
 	return nil
 }
 
+// startOfDay returns midnight at the start of t's day.
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// expectedTime returns the actual time if the event has happened, otherwise the
+// realtime forecast. Returns the zero time when no realtime data is available.
+func expectedTime(td *temporalData) time.Time {
+	if td.RealtimeActual != "" {
+		return parseAPITime(td.RealtimeActual)
+	}
+	return parseAPITime(td.RealtimeForecast)
+}
+
 // parseAPITime parses an ISO 8601 datetime string from the API.
 // Times without a timezone offset are treated as local time.
 func parseAPITime(s string) time.Time {
@@ -0,0 +1,314 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+	"github.com/baz-sh/rtt-cli/internal/rttfake"
+)
+
+const testRefreshToken = "test-refresh-token"
+
+// The fixture services from Euston to Manchester Piccadilly, in departure
+// order: on time, delayed, cancelled, and on time with a platform change.
+const (
+	onTime    = "gb-nr:P10001:2026-01-05"
+	delayed   = "gb-nr:P10002:2026-01-05"
+	cancelled = "gb-nr:P10003:2026-01-05"
+	changed   = "gb-nr:P10004:2026-01-05"
+)
+
+// newTestClient serves the built-in fixtures, moved to start shortly after
+// now, and returns a client that talks to them.
+func newTestClient(t *testing.T, opts ...api.Option) (*rttfake.Fake, *api.Client) {
+	t.Helper()
+	fake, err := rttfake.NewWithFixtures(testRefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake.ShiftTo(time.Now().Add(5 * time.Minute).Truncate(time.Minute))
+
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	opts = append([]api.Option{api.WithBaseURL(srv.URL), api.WithRateLimit(0, 0)}, opts...)
+	return fake, api.NewClient(testRefreshToken, opts...)
+}
+
+// addService adds a passenger train alongside the fixtures, calling at each
+// "CODE 15:04" on the day they were recorded, and moves them all to start
+// shortly after now again.
+func addService(fake *rttfake.Fake, uniqueIdentity string, calls ...string) {
+	svc := rttfake.Service{
+		ScheduleMetadata: rttfake.ScheduleMetadata{
+			UniqueIdentity:     uniqueIdentity,
+			Operator:           rttfake.Operator{Name: "Test Trains"},
+			InPassengerService: true,
+		},
+	}
+	for _, call := range calls {
+		code, at, _ := strings.Cut(call, " ")
+		t := "2026-01-05T" + at + ":00Z"
+		svc.Locations = append(svc.Locations, rttfake.Location{
+			Location: rttfake.Place{Description: code, ShortCodes: []string{code}},
+			TemporalData: rttfake.TemporalData{
+				Arrival:   &rttfake.Times{ScheduleAdvertised: t},
+				Departure: &rttfake.Times{ScheduleAdvertised: t},
+			},
+		})
+	}
+	fake.ShiftTo(time.Date(2026, 1, 5, 8, 0, 0, 0, time.UTC))
+	fake.AddService(svc)
+	fake.ShiftTo(time.Now().Add(5 * time.Minute).Truncate(time.Minute))
+}
+
+// departuresByID indexes a search's departures by service ID.
+func departuresByID(deps []api.Departure) map[string]api.Departure {
+	byID := make(map[string]api.Departure, len(deps))
+	for _, dep := range deps {
+		byID[dep.ServiceID] = dep
+	}
+	return byID
+}
+
+func TestGetDepartures(t *testing.T) {
+	_, client := newTestClient(t)
+
+	result, err := client.GetDepartures(context.Background(), "EUS", "MAN", api.SearchOptions{})
+	if err != nil {
+		t.Fatalf("GetDepartures: %v", err)
+	}
+	if len(result.Skipped) != 0 {
+		t.Errorf("Skipped = %v, want none", result.Skipped)
+	}
+
+	var ids []string
+	for _, dep := range result.Departures {
+		ids = append(ids, dep.ServiceID)
+	}
+	want := []string{onTime, delayed, cancelled, changed}
+	if strings.Join(ids, " ") != strings.Join(want, " ") {
+		t.Fatalf("departures = %v, want %v", ids, want)
+	}
+
+	tests := []struct {
+		id                 string
+		lateMinutes        int
+		cancelled          bool
+		cancellationReason string
+		depPlatform        string
+		arrPlatform        string
+		duration           string
+	}{
+		{id: onTime, depPlatform: "15", arrPlatform: "5", duration: "2hr 7min"},
+		{id: delayed, lateMinutes: 7, depPlatform: "14", arrPlatform: "6", duration: "2hr 7min"},
+		{id: cancelled, cancelled: true, cancellationReason: "This train has been cancelled because of a shortage of train crew", depPlatform: "16", duration: "2hr 7min"},
+		{id: changed, depPlatform: "16", arrPlatform: "5", duration: "2hr 7min"},
+	}
+	byID := departuresByID(result.Departures)
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			dep := byID[tt.id]
+			if dep.LateMinutes != tt.lateMinutes {
+				t.Errorf("LateMinutes = %d, want %d", dep.LateMinutes, tt.lateMinutes)
+			}
+			if dep.Cancelled != tt.cancelled {
+				t.Errorf("Cancelled = %v, want %v", dep.Cancelled, tt.cancelled)
+			}
+			if dep.CancellationReason != tt.cancellationReason {
+				t.Errorf("CancellationReason = %q, want %q", dep.CancellationReason, tt.cancellationReason)
+			}
+			if dep.DeparturePlatform != tt.depPlatform || dep.Platform != tt.arrPlatform {
+				t.Errorf("platforms = %q, %q, want %q, %q", dep.DeparturePlatform, dep.Platform, tt.depPlatform, tt.arrPlatform)
+			}
+			if dep.Duration != tt.duration {
+				t.Errorf("Duration = %q, want %q", dep.Duration, tt.duration)
+			}
+			if dep.From != "EUS" || dep.To != "MAN" {
+				t.Errorf("From, To = %s, %s, want EUS, MAN", dep.From, dep.To)
+			}
+		})
+	}
+
+	dep := byID[delayed]
+	if got := dep.ExpectedDeparture.Sub(dep.ScheduledDeparture); got != 7*time.Minute {
+		t.Errorf("delayed train expected %v after booked time, want 7m", got)
+	}
+	if dep := byID[onTime]; !dep.HasRealtime() || !dep.ExpectedDeparture.Equal(dep.ScheduledDeparture) {
+		t.Errorf("on-time train expected at %v, booked %v", dep.ExpectedDeparture, dep.ScheduledDeparture)
+	}
+}
+
+func TestGetDeparturesFromGroup(t *testing.T) {
+	fake, client := newTestClient(t, api.WithStationGroups(map[string][]string{"G:LON": {"EUS", "KGX", "STP"}}))
+
+	// A train calling at two stations of the group, so searches from both
+	// find it
+	const twice = "gb-nr:T10001:2026-01-05"
+	addService(fake, twice, "STP 08:30", "EUS 08:35", "MAN 10:40")
+
+	result, err := client.GetDepartures(context.Background(), "G:LON", "MAN", api.SearchOptions{})
+	if err != nil {
+		t.Fatalf("GetDepartures: %v", err)
+	}
+	if got := fake.Requests(rttfake.PathLocation); got != 3 {
+		t.Errorf("location requests = %d, want one for each station in the group", got)
+	}
+
+	var ids []string
+	for i, dep := range result.Departures {
+		ids = append(ids, dep.ServiceID)
+		if i > 0 && dep.ScheduledDeparture.Before(result.Departures[i-1].ScheduledDeparture) {
+			t.Errorf("%s leaves at %v, before the one listed above it", dep.ServiceID, dep.ScheduledDeparture)
+		}
+		if dep.From != "EUS" || dep.To != "MAN" {
+			t.Errorf("%s runs from %s to %s, want EUS to MAN", dep.ServiceID, dep.From, dep.To)
+		}
+	}
+	want := []string{onTime, delayed, twice, cancelled, changed}
+	if strings.Join(ids, " ") != strings.Join(want, " ") {
+		t.Errorf("departures = %v, want %v", ids, want)
+	}
+}
+
+func TestGetDeparturesScriptedCancellation(t *testing.T) {
+	fake, client := newTestClient(t)
+	if err := fake.Cancel(changed, "a fault with the train"); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.GetDepartures(context.Background(), "EUS", "MAN", api.SearchOptions{})
+	if err != nil {
+		t.Fatalf("GetDepartures: %v", err)
+	}
+	dep := departuresByID(result.Departures)[changed]
+	if !dep.Cancelled || dep.CancellationReason != "a fault with the train" {
+		t.Errorf("Cancelled, CancellationReason = %v, %q, want true, %q", dep.Cancelled, dep.CancellationReason, "a fault with the train")
+	}
+}
+
+func TestGetDeparturesVia(t *testing.T) {
+	_, client := newTestClient(t)
+
+	result, err := client.GetDepartures(context.Background(), "EUS", "MAN", api.SearchOptions{Via: []string{"SOT", "SOT"}})
+	if err != nil {
+		t.Fatalf("GetDepartures: %v", err)
+	}
+	var ids []string
+	for _, dep := range result.Departures {
+		ids = append(ids, dep.ServiceID)
+		if strings.Join(dep.CallsAt, ",") != "Stoke-on-Trent" {
+			t.Errorf("%s CallsAt = %v, want [Stoke-on-Trent]", dep.ServiceID, dep.CallsAt)
+		}
+	}
+	want := []string{onTime, cancelled, changed}
+	if strings.Join(ids, " ") != strings.Join(want, " ") {
+		t.Errorf("departures via SOT = %v, want %v", ids, want)
+	}
+}
+
+func TestGetDeparturesNoContent(t *testing.T) {
+	fake, client := newTestClient(t)
+	fake.NoContent(delayed)
+
+	result, err := client.GetDepartures(context.Background(), "EUS", "MAN", api.SearchOptions{})
+	if err != nil {
+		t.Fatalf("GetDepartures: %v", err)
+	}
+	byID := departuresByID(result.Departures)
+	if _, ok := byID[delayed]; ok {
+		t.Errorf("service answered with 204 was listed as a departure")
+	}
+	if len(byID) != 3 {
+		t.Errorf("got %d departures, want the other 3", len(byID))
+	}
+}
+
+func TestGetDeparturesServerError(t *testing.T) {
+	fake, client := newTestClient(t, api.WithConcurrency(1))
+	fake.FailNext(rttfake.PathService, http.StatusInternalServerError, 1)
+
+	result, err := client.GetDepartures(context.Background(), "EUS", "MAN", api.SearchOptions{})
+	if err != nil {
+		t.Fatalf("GetDepartures: %v", err)
+	}
+	if len(result.Departures) != 3 {
+		t.Errorf("got %d departures, want 3", len(result.Departures))
+	}
+	if len(result.Skipped) != 1 {
+		t.Fatalf("Skipped = %v, want one service", result.Skipped)
+	}
+	skipped := result.Skipped[0]
+	if !errors.Is(skipped, api.ErrUpstream) {
+		t.Errorf("skipped error = %v, want ErrUpstream", skipped.Err)
+	}
+	var statusErr *api.StatusError
+	if !errors.As(skipped, &statusErr) || statusErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("skipped error = %v, want a 500 StatusError", skipped.Err)
+	}
+	if _, ok := departuresByID(result.Departures)[skipped.ServiceID]; ok {
+		t.Errorf("skipped service %s was also listed as a departure", skipped.ServiceID)
+	}
+}
+
+func TestGetDeparturesRateLimited(t *testing.T) {
+	fake, client := newTestClient(t)
+	fake.RateLimitNext(rttfake.PathService, 1, time.Second)
+
+	start := time.Now()
+	result, err := client.GetDepartures(context.Background(), "EUS", "MAN", api.SearchOptions{})
+	if err != nil {
+		t.Fatalf("GetDepartures: %v", err)
+	}
+	if len(result.Departures) != 4 || len(result.Skipped) != 0 {
+		t.Errorf("got %d departures and %d skipped, want 4 and none", len(result.Departures), len(result.Skipped))
+	}
+	if got := fake.Requests(rttfake.PathService); got != 5 {
+		t.Errorf("service requests = %d, want 5 (one retried)", got)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("search took %v, want at least the 1s Retry-After", elapsed)
+	}
+}
+
+func TestGetDeparturesExpiredToken(t *testing.T) {
+	fake, client := newTestClient(t)
+	ctx := context.Background()
+
+	if _, err := client.GetDepartures(ctx, "EUS", "MAN", api.SearchOptions{}); err != nil {
+		t.Fatalf("first GetDepartures: %v", err)
+	}
+	if got := fake.TokensIssued(); got != 1 {
+		t.Fatalf("TokensIssued = %d after the first search, want 1", got)
+	}
+
+	fake.ExpireTokens()
+	result, err := client.GetDepartures(ctx, "EUS", "MAN", api.SearchOptions{})
+	if err != nil {
+		t.Fatalf("GetDepartures after tokens expired: %v", err)
+	}
+	if len(result.Departures) != 4 {
+		t.Errorf("got %d departures, want 4", len(result.Departures))
+	}
+	if got := fake.TokensIssued(); got != 2 {
+		t.Errorf("TokensIssued = %d, want 2", got)
+	}
+}
+
+func TestBadRefreshToken(t *testing.T) {
+	fake, _ := newTestClient(t)
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+	client := api.NewClient("wrong", api.WithBaseURL(srv.URL))
+
+	_, err := client.GetDepartures(context.Background(), "EUS", "MAN", api.SearchOptions{})
+	if !errors.Is(err, api.ErrUnauthorized) {
+		t.Errorf("err = %v, want ErrUnauthorized", err)
+	}
+}
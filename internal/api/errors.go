@@ -0,0 +1,77 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Errors reported by the client, for use with errors.Is. Responses with an
+// unexpected status are returned as a *StatusError, which matches one of these.
+var (
+	ErrUnauthorized = errors.New("API token was rejected")
+	ErrRateLimited  = errors.New("API rate limit exceeded")
+	ErrNotFound     = errors.New("not found")
+	ErrUpstream     = errors.New("API error")
+)
+
+// maxErrorBody is how much of an error response body is kept for the message.
+const maxErrorBody = 200
+
+// StatusError is an unsuccessful response from the API. Use errors.As to get
+// the status code and the start of the response body.
+type StatusError struct {
+	StatusCode int
+	Body       string        // first part of the response body, for diagnostics
+	RetryAfter time.Duration // how long the API asked the client to wait, or 0
+}
+
+func (e *StatusError) Error() string {
+	msg := fmt.Sprintf("API returned status %d", e.StatusCode)
+	if e.Body != "" {
+		msg += ": " + e.Body
+	}
+	return msg
+}
+
+// Is matches the sentinel error for the response status.
+func (e *StatusError) Is(target error) bool {
+	switch e.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return target == ErrUnauthorized
+	case http.StatusTooManyRequests:
+		return target == ErrRateLimited
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	}
+	return target == ErrUpstream
+}
+
+// newStatusError builds a StatusError from a response, reading a snippet of its body.
+func newStatusError(resp *http.Response) *StatusError {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	return &StatusError{
+		StatusCode: resp.StatusCode,
+		Body:       strings.Join(strings.Fields(string(body)), " "),
+		RetryAfter: retryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+}
+
+// retryAfter parses a Retry-After header, which is either a number of seconds
+// or an HTTP date. It returns 0 if the header is missing or invalid.
+func retryAfter(header string, now time.Time) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(header); err == nil {
+		return max(0, time.Duration(secs)*time.Second)
+	}
+	if t, err := http.ParseTime(header); err == nil {
+		return max(0, t.Sub(now))
+	}
+	return 0
+}
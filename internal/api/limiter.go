@@ -0,0 +1,167 @@
+package api
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRateLimit is how many requests per second the client sends on
+	// average, unless WithRateLimit is given.
+	DefaultRateLimit = 5
+
+	// DefaultBurst is how many requests may be sent at once after a quiet spell.
+	DefaultBurst = 10
+
+	// maxAttempts is how many times a rate-limited request is tried.
+	maxAttempts = 5
+
+	// Backoff after a rate-limited response without a Retry-After header
+	// doubles from backoffBase with each consecutive one, up to backoffMax.
+	backoffBase = 500 * time.Millisecond
+	backoffMax  = 30 * time.Second
+)
+
+// LimiterStatus describes whether the client is holding requests back because
+// the API asked it to slow down, so a UI can say so rather than spin.
+type LimiterStatus struct {
+	Throttled bool      // requests are paused after a rate-limited response
+	RetryAt   time.Time // when paused requests resume, if Throttled
+	Waiting   int       // requests queued for a concurrency slot or the rate limit
+}
+
+// RetryIn returns how long until paused requests resume, rounded up to a
+// whole second for display.
+func (s LimiterStatus) RetryIn(now time.Time) time.Duration {
+	if !s.Throttled || !s.RetryAt.After(now) {
+		return 0
+	}
+	return (s.RetryAt.Sub(now) + time.Second - 1).Truncate(time.Second)
+}
+
+// limiter paces every request a client sends, whichever call it belongs to. It
+// caps how many are in flight, spreads them out with a token bucket, and
+// pauses them all when the API responds with 429 Too Many Requests.
+type limiter struct {
+	slots chan struct{} // one per request in flight
+
+	mu          sync.Mutex
+	rate        float64 // tokens added per second, or 0 for no limit
+	burst       float64
+	tokens      float64
+	last        time.Time // when tokens was last topped up
+	pausedUntil time.Time
+	failures    int // consecutive rate-limited responses
+	waiting     int
+}
+
+func newLimiter(rate float64, burst, concurrency int) *limiter {
+	return &limiter{
+		slots:  make(chan struct{}, concurrency),
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+}
+
+// wait blocks until a request may be sent, returning a function to call once
+// it has completed. It fails only if ctx is done first.
+func (l *limiter) wait(ctx context.Context) (release func(), err error) {
+	l.addWaiting(1)
+	defer l.addWaiting(-1)
+
+	select {
+	case l.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	release = func() { <-l.slots }
+
+	for {
+		d := l.reserve(time.Now())
+		if d == 0 {
+			return release, nil
+		}
+		if err := sleep(ctx, d); err != nil {
+			release()
+			return nil, err
+		}
+	}
+}
+
+// reserve takes a token from the bucket and returns zero, or returns how long
+// to wait before trying again.
+func (l *limiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+	if l.rate <= 0 {
+		return 0
+	}
+
+	if !l.last.IsZero() {
+		l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// throttle records a rate-limited response and pauses all requests, for
+// retryAfter if the API said how long to wait, or otherwise for a backoff
+// that grows with each consecutive rate-limited response.
+func (l *limiter) throttle(retryAfter time.Duration, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.failures++
+	d := retryAfter
+	if d <= 0 {
+		d = backoff(l.failures)
+	}
+	if until := now.Add(d); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// succeeded records a response that wasn't rate limited, resetting the backoff.
+func (l *limiter) succeeded() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.failures = 0
+}
+
+func (l *limiter) addWaiting(n int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.waiting += n
+}
+
+func (l *limiter) status(now time.Time) LimiterStatus {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return LimiterStatus{
+		Throttled: now.Before(l.pausedUntil),
+		RetryAt:   l.pausedUntil,
+		Waiting:   l.waiting,
+	}
+}
+
+// backoff returns the pause after n consecutive rate-limited responses. Half
+// of it is random, so requests that were throttled together don't all retry
+// at the same moment.
+func backoff(n int) time.Duration {
+	d := backoffMax
+	if n < 16 {
+		d = min(backoffMax, backoffBase<<(n-1))
+	}
+	return d/2 + rand.N(d/2+1)
+}
@@ -0,0 +1,178 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// The limiter is driven with explicit times here, so refills and pauses can
+// be checked to the millisecond without waiting for them.
+
+func TestLimiterRefill(t *testing.T) {
+	l := newLimiter(5, 2, 10)
+	t0 := time.Date(2026, 1, 5, 8, 0, 0, 0, time.UTC)
+
+	steps := []struct {
+		after time.Duration // since t0
+		want  time.Duration // how long reserve says to wait
+	}{
+		// The burst goes straight away
+		{0, 0},
+		{0, 0},
+		// Then a token every fifth of a second
+		{0, 200 * time.Millisecond},
+		{100 * time.Millisecond, 100 * time.Millisecond},
+		{200 * time.Millisecond, 0},
+		{250 * time.Millisecond, 150 * time.Millisecond},
+		// A long quiet spell refills no more than the burst
+		{10 * time.Second, 0},
+		{10 * time.Second, 0},
+		{10 * time.Second, 200 * time.Millisecond},
+	}
+	for i, step := range steps {
+		got := l.reserve(t0.Add(step.after))
+		if d := got - step.want; d < -time.Millisecond || d > time.Millisecond {
+			t.Errorf("step %d, at +%v: reserve = %v, want %v", i, step.after, got, step.want)
+		}
+	}
+}
+
+func TestLimiterUnlimited(t *testing.T) {
+	l := newLimiter(0, 0, 10)
+	now := time.Now()
+	for i := range 100 {
+		if d := l.reserve(now); d != 0 {
+			t.Fatalf("request %d: reserve = %v with no rate limit, want 0", i, d)
+		}
+	}
+}
+
+func TestLimiterThrottle(t *testing.T) {
+	l := newLimiter(0, 0, 10)
+	t0 := time.Date(2026, 1, 5, 8, 0, 0, 0, time.UTC)
+
+	l.throttle(2*time.Second, t0)
+	if d := l.reserve(t0.Add(500 * time.Millisecond)); d != 1500*time.Millisecond {
+		t.Errorf("reserve during a 2s pause, 0.5s in = %v, want 1.5s", d)
+	}
+	s := l.status(t0.Add(time.Second))
+	if !s.Throttled || !s.RetryAt.Equal(t0.Add(2*time.Second)) {
+		t.Errorf("status during the pause = %+v, want throttled until +2s", s)
+	}
+	if got := s.RetryIn(t0.Add(1100 * time.Millisecond)); got != time.Second {
+		t.Errorf("RetryIn with 0.9s left = %v, want it rounded up to 1s", got)
+	}
+
+	// A shorter pause doesn't cut a longer one short
+	l.throttle(time.Second, t0.Add(500*time.Millisecond))
+	if s := l.status(t0); !s.RetryAt.Equal(t0.Add(2 * time.Second)) {
+		t.Errorf("RetryAt after a shorter pause = %v, want +2s", s.RetryAt.Sub(t0))
+	}
+
+	if s := l.status(t0.Add(2 * time.Second)); s.Throttled || s.RetryIn(t0.Add(2*time.Second)) != 0 {
+		t.Errorf("status once the pause is over = %+v, want not throttled", s)
+	}
+	if d := l.reserve(t0.Add(2 * time.Second)); d != 0 {
+		t.Errorf("reserve once the pause is over = %v, want 0", d)
+	}
+}
+
+func TestLimiterBackoff(t *testing.T) {
+	l := newLimiter(0, 0, 10)
+	now := time.Date(2026, 1, 5, 8, 0, 0, 0, time.UTC)
+
+	// Each consecutive 429 without a Retry-After doubles the pause, half of
+	// it random, until it reaches backoffMax
+	for n := 1; n <= 20; n++ {
+		full := min(backoffMax, backoffBase<<(n-1))
+		l.throttle(0, now)
+		pause := l.status(now).RetryAt.Sub(now)
+		if pause < full/2 || pause > full {
+			t.Errorf("after %d rate-limited responses, paused %v, want %v to %v", n, pause, full/2, full)
+		}
+		now = now.Add(pause)
+	}
+
+	// A success starts the backoff again
+	l.succeeded()
+	l.throttle(0, now)
+	if pause := l.status(now).RetryAt.Sub(now); pause > backoffBase {
+		t.Errorf("after a success and one more rate-limited response, paused %v, want at most %v", pause, backoffBase)
+	}
+}
+
+func TestBackoffCap(t *testing.T) {
+	for _, n := range []int{7, 15, 16, 63, 64, 1000} {
+		for range 100 {
+			if d := backoff(n); d < backoffMax/2 || d > backoffMax {
+				t.Fatalf("backoff(%d) = %v, want %v to %v", n, d, backoffMax/2, backoffMax)
+			}
+		}
+	}
+}
+
+func TestLimiterSlots(t *testing.T) {
+	l := newLimiter(0, 0, 2)
+	ctx := context.Background()
+
+	release1, err := l.wait(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// A third request waits for one of the first two to finish
+	acquired := make(chan func())
+	go func() {
+		release, err := l.wait(ctx)
+		if err != nil {
+			t.Error(err)
+		}
+		acquired <- release
+	}()
+	waitFor(t, func() bool { return l.status(time.Now()).Waiting == 1 })
+	select {
+	case <-acquired:
+		t.Fatal("a third request got a slot while two were in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	release1()
+	select {
+	case release := <-acquired:
+		release()
+	case <-time.After(time.Second):
+		t.Fatal("the third request didn't get the released slot")
+	}
+	if n := l.status(time.Now()).Waiting; n != 0 {
+		t.Errorf("Waiting = %d once every request has a slot, want 0", n)
+	}
+
+	// A request given up on while waiting doesn't keep a slot
+	if _, err := l.wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+	cancelled, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if _, err := l.wait(cancelled); err != context.DeadlineExceeded {
+		t.Errorf("wait with every slot taken = %v, want DeadlineExceeded", err)
+	}
+	if n := len(l.slots); n != 2 {
+		t.Errorf("%d slots taken after a request gave up, want 2", n)
+	}
+}
+
+// waitFor polls cond until it is true, failing the test after a second.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
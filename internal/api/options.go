@@ -0,0 +1,126 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultBaseURL is the Realtime Trains API used unless WithBaseURL is given.
+	DefaultBaseURL = "https://data.rtt.io"
+
+	// DefaultTimeout bounds each HTTP request unless WithTimeout is given.
+	DefaultTimeout = 10 * time.Second
+
+	// DefaultConcurrency is how many requests run at once.
+	DefaultConcurrency = 3
+
+	// DefaultUserAgent identifies the client to the API.
+	DefaultUserAgent = "rtt-cli"
+)
+
+// Option configures a Client created by NewClient.
+type Option func(*clientOptions)
+
+type clientOptions struct {
+	baseURL     string
+	httpClient  *http.Client
+	transport   http.RoundTripper
+	timeout     time.Duration
+	userAgent   string
+	concurrency int
+	rateLimit   float64
+	burst       int
+	now         func() time.Time
+	cache       *Cache
+	groups      map[string][]string
+}
+
+// WithBaseURL points the client at another API host, such as a local mock or
+// a caching proxy, e.g. "http://localhost:8080".
+func WithBaseURL(baseURL string) Option {
+	return func(o *clientOptions) { o.baseURL = strings.TrimRight(baseURL, "/") }
+}
+
+// WithHTTPClient makes requests with the given HTTP client. WithTransport and
+// WithTimeout, if also given, apply to a copy of it.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(o *clientOptions) { o.httpClient = hc }
+}
+
+// WithTransport sends requests through the given RoundTripper, e.g. one with a
+// custom proxy or CA pool.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(o *clientOptions) { o.transport = rt }
+}
+
+// WithTimeout sets how long a single HTTP request may take. Use a context
+// deadline to bound a whole search.
+func WithTimeout(d time.Duration) Option {
+	return func(o *clientOptions) { o.timeout = d }
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(o *clientOptions) { o.userAgent = userAgent }
+}
+
+// WithConcurrency sets how many requests the client runs at once, across all
+// of its calls. Values below one are ignored.
+func WithConcurrency(n int) Option {
+	return func(o *clientOptions) {
+		if n >= 1 {
+			o.concurrency = n
+		}
+	}
+}
+
+// WithRateLimit sets how many requests per second the client sends on
+// average, and how many it may send at once after a quiet spell. A rate of
+// zero turns the limit off; a burst below one is taken as one.
+func WithRateLimit(perSecond float64, burst int) Option {
+	return func(o *clientOptions) {
+		o.rateLimit = max(0, perSecond)
+		o.burst = max(1, burst)
+	}
+}
+
+// WithClock makes the client read the current time from now instead of the
+// system clock. It decides which trains have departed and how long until the
+// rest leave, so replaying a recorded session needs the time it was recorded.
+func WithClock(now func() time.Time) Option {
+	return func(o *clientOptions) { o.now = now }
+}
+
+// WithCache keeps service details in cache between searches, so each train's
+// schedule is only downloaded again once its cached copy goes stale.
+func WithCache(cache *Cache) Option {
+	return func(o *clientOptions) { o.cache = cache }
+}
+
+// WithStationGroups defines station groups, keyed by group code, whose values
+// are the CRS codes of their member stations. Searches from or to a group
+// code cover every member station, and their results are merged.
+func WithStationGroups(groups map[string][]string) Option {
+	return func(o *clientOptions) { o.groups = groups }
+}
+
+// buildHTTPClient returns the HTTP client described by the options, without
+// modifying one passed to WithHTTPClient.
+func (o *clientOptions) buildHTTPClient() *http.Client {
+	if o.httpClient == nil {
+		return &http.Client{Timeout: o.timeout, Transport: o.transport}
+	}
+	if o.transport == nil && o.timeout == 0 {
+		return o.httpClient
+	}
+	hc := *o.httpClient
+	if o.transport != nil {
+		hc.Transport = o.transport
+	}
+	if o.timeout != 0 {
+		hc.Timeout = o.timeout
+	}
+	return &hc
+}
@@ -0,0 +1,140 @@
+package api
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// arriveByWindow is how far before the arrival deadline an arrive-by search looks for trains.
+const arriveByWindow = 3 * time.Hour
+
+// SearchOptions controls the time window of a departures search.
+type SearchOptions struct {
+	// At is the time to search from. The zero value means now.
+	At time.Time
+	// ArriveBy finds trains arriving at the destination by At, rather than
+	// trains departing from At onwards.
+	ArriveBy bool
+	// Via lists CRS codes of stations a train must call at between the origin
+	// and the destination.
+	Via []string
+	// Avoid lists CRS codes of stations a train must not call at between the
+	// origin and the destination.
+	Avoid []string
+}
+
+// searchTime returns the effective time of the search.
+func (o SearchOptions) searchTime(now time.Time) time.Time {
+	if o.At.IsZero() {
+		return now
+	}
+	return o.At
+}
+
+// ParseSearchTime parses a user-supplied search time. It accepts a full date
+// and time ("2026-10-18T07:30" or "2026-10-18 07:30") or a bare time ("07:30"),
+// which is taken as the next occurrence of that time after now. An empty
+// string or "now" returns the zero time.
+func ParseSearchTime(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.EqualFold(s, "now") {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range []string{"2006-01-02T15:04", "2006-01-02 15:04", "2006-01-02T15:04:05"} {
+		if t, err := time.ParseInLocation(layout, s, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+
+	if t, err := time.ParseInLocation("15:04", s, now.Location()); err == nil {
+		next := time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, now.Location())
+		if next.Before(now) {
+			next = next.AddDate(0, 0, 1)
+		}
+		return next, nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q: use HH:MM or YYYY-MM-DDTHH:MM", s)
+}
+
+// ParseStationCodes splits a comma- or space-separated list of CRS codes, as
+// given to --via and --avoid, into upper-case codes. Repeated codes are only
+// listed once.
+func ParseStationCodes(s string) []string {
+	var codes []string
+	for _, code := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if code = strings.ToUpper(code); !slices.Contains(codes, code) {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+// matchCallingPattern checks a service's stops between from and to against
+// the via and avoid stations of a search. It returns the names of the via
+// stations called at, in calling order, and whether the service qualifies.
+func matchCallingPattern(locations []serviceLocation, from, to string, opts SearchOptions) ([]string, bool) {
+	if len(opts.Via) == 0 && len(opts.Avoid) == 0 {
+		return nil, true
+	}
+
+	start, end := -1, -1
+	for i := range locations {
+		if start == -1 && hasShortCode(&locations[i], from) {
+			start = i
+		} else if start != -1 && hasShortCode(&locations[i], to) {
+			end = i
+			break
+		}
+	}
+	if start == -1 || end == -1 {
+		return nil, false
+	}
+
+	// Count each via station once, however often it was listed
+	want := map[string]bool{}
+	for _, code := range opts.Via {
+		want[code] = true
+	}
+
+	var callsAt []string
+	seen := map[string]bool{}
+	for i := start + 1; i < end; i++ {
+		loc := &locations[i]
+		if !isPublicCall(loc) {
+			continue
+		}
+		for _, code := range opts.Avoid {
+			if hasShortCode(loc, code) {
+				return nil, false
+			}
+		}
+		for _, code := range opts.Via {
+			if hasShortCode(loc, code) && !seen[code] {
+				seen[code] = true
+				callsAt = append(callsAt, locationName(loc))
+			}
+		}
+	}
+
+	return callsAt, len(seen) == len(want)
+}
+
+// isPublicCall reports whether passengers can board or alight at a location,
+// as opposed to the train just passing through.
+func isPublicCall(loc *serviceLocation) bool {
+	arr, dep := loc.TemporalData.Arrival, loc.TemporalData.Departure
+	return (arr != nil && arr.ScheduleAdvertised != "") || (dep != nil && dep.ScheduleAdvertised != "")
+}
+
+func hasShortCode(loc *serviceLocation, code string) bool {
+	for _, sc := range loc.Location.ShortCodes {
+		if strings.EqualFold(sc, code) {
+			return true
+		}
+	}
+	return false
+}
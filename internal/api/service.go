@@ -0,0 +1,163 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ServiceDetail is the full stopping pattern of a single train service.
+type ServiceDetail struct {
+	ID            string
+	Operator      string
+	CallingPoints []CallingPoint
+}
+
+// CallingPoint is one stop in a service's schedule. Times are zero when
+// unknown: booked times are absent at the origin (arrival) and destination
+// (departure), and realtime times are only present once the service is running.
+type CallingPoint struct {
+	Name              string
+	Code              string
+	BookedArrival     time.Time
+	ExpectedArrival   time.Time
+	ActualArrival     time.Time
+	BookedDeparture   time.Time
+	ExpectedDeparture time.Time
+	ActualDeparture   time.Time
+	BookedPlatform    string
+	Platform          string // most up-to-date platform
+	Cancelled         bool
+}
+
+// Origin returns the first calling point of the service.
+func (s *ServiceDetail) Origin() CallingPoint {
+	if len(s.CallingPoints) == 0 {
+		return CallingPoint{}
+	}
+	return s.CallingPoints[0]
+}
+
+// Destination returns the last calling point of the service.
+func (s *ServiceDetail) Destination() CallingPoint {
+	if len(s.CallingPoints) == 0 {
+		return CallingPoint{}
+	}
+	return s.CallingPoints[len(s.CallingPoints)-1]
+}
+
+// Position returns the index of the last calling point the train has reported
+// at, and whether it is still standing there. Returns -1 if the train has not
+// yet started its journey.
+func (s *ServiceDetail) Position() (index int, atStation bool) {
+	index = -1
+	for i, cp := range s.CallingPoints {
+		if !cp.ActualArrival.IsZero() || !cp.ActualDeparture.IsZero() {
+			index = i
+		}
+	}
+	if index == -1 {
+		return -1, false
+	}
+	cp := s.CallingPoints[index]
+	return index, cp.ActualDeparture.IsZero()
+}
+
+// GetServiceDetail fetches the full stopping pattern of a service, as
+// identified by Departure.ServiceID.
+func (c *Client) GetServiceDetail(ctx context.Context, serviceID string) (*ServiceDetail, error) {
+	svcResp, err := c.fetchService(ctx, serviceID, false)
+	if err != nil {
+		return nil, err
+	}
+	if svcResp == nil {
+		return nil, fmt.Errorf("no details available for service %s", serviceID)
+	}
+	return buildServiceDetail(svcResp), nil
+}
+
+// GetServices returns the full stopping pattern of every passenger service
+// calling at a station within window of the given time, in no particular order.
+func (c *Client) GetServices(ctx context.Context, code string, at time.Time, window time.Duration) ([]ServiceDetail, error) {
+	services, err := c.fetchServices(ctx, strings.ToUpper(code), "", at, window)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*ServiceDetail, len(services))
+	// Services that fail to load are left out: callers use these for planning
+	// rather than listing them, so a partial set is still useful
+	_, err = c.forEachService(ctx, services, func(idx int, s serviceInfo, svcResp *serviceResponse) {
+		results[idx] = buildServiceDetail(svcResp)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var details []ServiceDetail
+	for _, detail := range results {
+		if detail != nil {
+			details = append(details, *detail)
+		}
+	}
+	return details, nil
+}
+
+// Index returns the position of the first calling point with the given CRS
+// code, or -1 if the service does not call there.
+func (s *ServiceDetail) Index(code string) int {
+	for i, cp := range s.CallingPoints {
+		if strings.EqualFold(cp.Code, code) {
+			return i
+		}
+	}
+	return -1
+}
+
+func buildServiceDetail(svcResp *serviceResponse) *ServiceDetail {
+	detail := &ServiceDetail{
+		ID:       svcResp.Service.ScheduleMetadata.UniqueIdentity,
+		Operator: svcResp.Service.ScheduleMetadata.Operator.Name,
+	}
+
+	for _, loc := range svcResp.Service.Locations {
+		cp := CallingPoint{
+			Name: locationName(&loc),
+		}
+		if len(loc.Location.ShortCodes) > 0 {
+			cp.Code = loc.Location.ShortCodes[0]
+		}
+		if arr := loc.TemporalData.Arrival; arr != nil {
+			cp.BookedArrival = parseAPITime(arr.ScheduleAdvertised)
+			cp.ExpectedArrival = parseAPITime(arr.RealtimeForecast)
+			cp.ActualArrival = parseAPITime(arr.RealtimeActual)
+			cp.Cancelled = arr.IsCancelled
+		}
+		if dep := loc.TemporalData.Departure; dep != nil {
+			cp.BookedDeparture = parseAPITime(dep.ScheduleAdvertised)
+			cp.ExpectedDeparture = parseAPITime(dep.RealtimeForecast)
+			cp.ActualDeparture = parseAPITime(dep.RealtimeActual)
+			cp.Cancelled = cp.Cancelled || dep.IsCancelled
+		}
+		if loc.LocationMetadata.Platform != nil {
+			cp.BookedPlatform = loc.LocationMetadata.Platform.Planned
+			cp.Platform = bestPlatform(loc.LocationMetadata.Platform)
+		}
+		detail.CallingPoints = append(detail.CallingPoints, cp)
+	}
+
+	return detail
+}
+
+// locationName returns a display name for a location, falling back to its
+// CRS code if the API did not supply a description.
+func locationName(loc *serviceLocation) string {
+	if loc.Location.Description != "" {
+		return loc.Location.Description
+	}
+	if len(loc.Location.ShortCodes) > 0 {
+		return loc.Location.ShortCodes[0]
+	}
+	return "Unknown"
+}
@@ -0,0 +1,309 @@
+package api
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DepartureEvent reports the outcome for one service of a streaming search.
+// Events arrive in departure order, one for every service the search found.
+type DepartureEvent struct {
+	Index     int           // position of the service in the search, from 0
+	Departure *Departure    // nil if the service doesn't match the search or failed to load
+	Skipped   *ServiceError // why the service failed to load, if it did
+}
+
+// DepartureStream delivers the results of a departures search as each
+// service's details load, soonest first. Details are only fetched on demand:
+// call Request to load more services, for example as the user scrolls towards
+// them.
+type DepartureStream struct {
+	client   *Client
+	ctx      context.Context
+	opts     SearchOptions
+	ref      time.Time // the time searched for
+	arriveBy time.Time // earliest departure kept by an arrive-by search
+	services []serviceInfo
+	events   chan DepartureEvent
+	more     chan struct{} // signalled when demand rises
+
+	mu     sync.Mutex
+	demand int // how many services, from the soonest, to load
+	err    error
+}
+
+// serviceResult is the outcome of loading one service's details.
+type serviceResult struct {
+	resp *serviceResponse
+	err  error
+}
+
+// StreamDepartures starts a search for direct services from one station, or
+// station group, to another, like GetDepartures, but returns as soon as the
+// list of services is known. Their details are fetched in the background,
+// soonest first, once requested with Request, and reported on Events. Cancel
+// ctx once the stream is no longer needed, or it waits for more requests
+// indefinitely.
+func (c *Client) StreamDepartures(ctx context.Context, from, to string, opts SearchOptions) (*DepartureStream, error) {
+	now := c.now()
+	from = strings.ToUpper(from)
+	to = strings.ToUpper(to)
+
+	ref := opts.searchTime(now)
+	timeFrom, window := ref, 24*time.Hour-time.Minute
+	if opts.ArriveBy {
+		timeFrom, window = ref.Add(-arriveByWindow), arriveByWindow
+	}
+
+	services, err := c.fetchServicesBetween(ctx, from, to, timeFrom, window)
+	if err != nil {
+		return nil, err
+	}
+
+	// Arrive-by searches keep trains from the start of the window, unless
+	// they have already left
+	arriveBy := timeFrom
+	if opts.At.IsZero() || opts.At.After(now) {
+		arriveBy = now
+	}
+
+	s := &DepartureStream{
+		client:   c,
+		ctx:      ctx,
+		opts:     opts,
+		ref:      ref,
+		arriveBy: arriveBy,
+		events:   make(chan DepartureEvent),
+		more:     make(chan struct{}, 1),
+	}
+
+	// Load the soonest trains first, and don't load ones that have already
+	// left at all
+	sort.SliceStable(services, func(i, j int) bool {
+		return parseAPITime(services[i].bookedDepartureTime).Before(parseAPITime(services[j].bookedDepartureTime))
+	})
+	cutoff := s.cutoff()
+	for _, svc := range services {
+		if leaving := svc.leavingAt(); leaving.IsZero() || !leaving.Before(cutoff) {
+			s.services = append(s.services, svc)
+		}
+	}
+
+	go s.run()
+	return s, nil
+}
+
+// Total returns how many services the search found. Some may not appear as
+// departures, because they don't match the via and avoid stations, have left
+// by the time their details load, or fail to load.
+func (s *DepartureStream) Total() int {
+	return len(s.services)
+}
+
+// Events returns the channel the results are delivered on. It is closed once
+// every service has been reported, or when the stream stops early; Err then
+// says why.
+func (s *DepartureStream) Events() <-chan DepartureEvent {
+	return s.events
+}
+
+// Request asks for the details of the first n services to be loaded, if they
+// haven't been already. It never blocks.
+func (s *DepartureStream) Request(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if n <= s.demand {
+		return
+	}
+	s.demand = n
+	select {
+	case s.more <- struct{}{}:
+	default:
+	}
+}
+
+// Err returns the reason the stream stopped before reporting every service,
+// such as the context being cancelled. It is nil while the stream is running
+// and after it completes.
+func (s *DepartureStream) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Collect requests the first n services and waits for them, returning their
+// departures and failures. It must not be used while another goroutine reads
+// Events.
+func (s *DepartureStream) Collect(n int) (DepartureResult, error) {
+	n = min(n, s.Total())
+	s.Request(n)
+
+	var result DepartureResult
+	for seen := 0; seen < n; seen++ {
+		ev, ok := <-s.events
+		if !ok {
+			return DepartureResult{}, s.Err()
+		}
+		if ev.Departure != nil {
+			result.Departures = append(result.Departures, *ev.Departure)
+		}
+		if ev.Skipped != nil {
+			result.Skipped = append(result.Skipped, *ev.Skipped)
+		}
+	}
+	return result, nil
+}
+
+// run loads requested services with as many workers as the client runs
+// requests at once, and reports them in order.
+func (s *DepartureStream) run() {
+	defer close(s.events)
+
+	results := make([]chan serviceResult, len(s.services))
+	for i := range results {
+		results[i] = make(chan serviceResult, 1)
+	}
+	jobs := make(chan int)
+	go s.dispatch(jobs)
+	for range cap(s.client.limiter.slots) {
+		go func() {
+			for i := range jobs {
+				resp, err := s.client.loadService(s.ctx, s.services[i])
+				results[i] <- serviceResult{resp: resp, err: err}
+			}
+		}()
+	}
+
+	for i, svc := range s.services {
+		var r serviceResult
+		select {
+		case r = <-results[i]:
+		case <-s.ctx.Done():
+			s.stop(s.ctx.Err())
+			return
+		}
+		select {
+		case s.events <- s.event(i, svc, r):
+		case <-s.ctx.Done():
+			s.stop(s.ctx.Err())
+			return
+		}
+	}
+}
+
+// dispatch hands out services to load, soonest first, as far as requested.
+func (s *DepartureStream) dispatch(jobs chan<- int) {
+	defer close(jobs)
+	for i := range s.services {
+		for !s.wanted(i) {
+			select {
+			case <-s.more:
+			case <-s.ctx.Done():
+				return
+			}
+		}
+		select {
+		case jobs <- i:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *DepartureStream) wanted(i int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return i < s.demand
+}
+
+func (s *DepartureStream) stop(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+// event builds the result for a loaded service, leaving out the departure if
+// it doesn't match the search.
+func (s *DepartureStream) event(i int, svc serviceInfo, r serviceResult) DepartureEvent {
+	ev := DepartureEvent{Index: i}
+	if r.err != nil {
+		skipped := newServiceError(svc, r.err)
+		ev.Skipped = &skipped
+		return ev
+	}
+
+	callsAt, ok := matchCallingPattern(r.resp.Service.Locations, svc.from, svc.to, s.opts)
+	if !ok {
+		return ev
+	}
+	dep := buildDeparture(r.resp, svc.to, svc, s.ref, s.client.now())
+	if dep == nil || dep.Departed(s.cutoff()) {
+		return ev
+	}
+	if s.opts.ArriveBy && (dep.ScheduledArrival.IsZero() || dep.ScheduledArrival.After(s.ref)) {
+		return ev
+	}
+	dep.CallsAt = callsAt
+	ev.Departure = dep
+	return ev
+}
+
+// cutoff returns the time before which departed trains are dropped. Searches
+// at an explicit time keep everything from that time, even if it is in the
+// past; searches from now drop trains as they leave.
+func (s *DepartureStream) cutoff() time.Time {
+	switch {
+	case s.opts.ArriveBy:
+		return s.arriveBy
+	case s.opts.At.IsZero():
+		return s.client.now()
+	}
+	return s.ref
+}
+
+// fetchServicesBetween finds the services from one station to another. When
+// either is a station group, every pair of member stations is searched at
+// once and the results merged. A train found between several pairs, such as
+// one calling at two stations of the destination group, is only listed for
+// the first.
+func (c *Client) fetchServicesBetween(ctx context.Context, from, to string, timeFrom time.Time, window time.Duration) ([]serviceInfo, error) {
+	type pair struct{ from, to string }
+	var pairs []pair
+	for _, f := range c.members(from) {
+		for _, t := range c.members(to) {
+			if f != t {
+				pairs = append(pairs, pair{f, t})
+			}
+		}
+	}
+
+	var wg sync.WaitGroup
+	found := make([][]serviceInfo, len(pairs))
+	errs := make([]error, len(pairs))
+	for i, p := range pairs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			found[i], errs[i] = c.fetchServices(ctx, p.from, p.to, timeFrom, window)
+		}()
+	}
+	wg.Wait()
+
+	var services []serviceInfo
+	seen := make(map[string]bool)
+	for i := range pairs {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		for _, svc := range found[i] {
+			if !seen[svc.uniqueIdentity] {
+				seen[svc.uniqueIdentity] = true
+				services = append(services, svc)
+			}
+		}
+	}
+	return services, nil
+}
@@ -0,0 +1,116 @@
+package api_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+	"github.com/baz-sh/rtt-cli/internal/rttfake"
+)
+
+func TestStreamDeparturesLoadsOnDemand(t *testing.T) {
+	fake, client := newTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.StreamDepartures(ctx, "EUS", "MAN", api.SearchOptions{})
+	if err != nil {
+		t.Fatalf("StreamDepartures: %v", err)
+	}
+	if stream.Total() != 4 {
+		t.Fatalf("Total = %d, want 4", stream.Total())
+	}
+	// settle gives the stream time to load anything it shouldn't have
+	settle := func() { time.Sleep(50 * time.Millisecond) }
+	settle()
+	if got := fake.Requests(rttfake.PathService); got != 0 {
+		t.Errorf("%d services loaded before any were requested", got)
+	}
+
+	result, err := stream.Collect(2)
+	if err != nil {
+		t.Fatalf("Collect(2): %v", err)
+	}
+	if len(result.Departures) != 2 || result.Departures[0].ServiceID != onTime || result.Departures[1].ServiceID != delayed {
+		t.Fatalf("Collect(2) = %v, want the two soonest", result.Departures)
+	}
+	settle()
+	if got := fake.Requests(rttfake.PathService); got != 2 {
+		t.Errorf("%d services loaded after Collect(2), want 2", got)
+	}
+
+	// Asking for fewer than are already loaded does nothing
+	stream.Request(1)
+	settle()
+	if got := fake.Requests(rttfake.PathService); got != 2 {
+		t.Errorf("%d services loaded after Request(1), want still 2", got)
+	}
+
+	stream.Request(3)
+	ev := <-stream.Events()
+	if ev.Index != 2 || ev.Departure == nil || ev.Departure.ServiceID != cancelled {
+		t.Errorf("third event = %+v, want index 2 for %s", ev, cancelled)
+	}
+	settle()
+	if got := fake.Requests(rttfake.PathService); got != 3 {
+		t.Errorf("%d services loaded after Request(3), want 3", got)
+	}
+
+	stream.Request(10)
+	ev = <-stream.Events()
+	if ev.Index != 3 || ev.Departure == nil || ev.Departure.ServiceID != changed {
+		t.Errorf("fourth event = %+v, want index 3 for %s", ev, changed)
+	}
+	if _, ok := <-stream.Events(); ok {
+		t.Error("Events still open after every service was reported")
+	}
+	if err := stream.Err(); err != nil {
+		t.Errorf("Err = %v after the stream completed", err)
+	}
+	if got := fake.Requests(rttfake.PathService); got != 4 {
+		t.Errorf("%d services loaded, want each of the 4 once", got)
+	}
+}
+
+func TestStreamDeparturesSoonestFirst(t *testing.T) {
+	fake, client := newTestClient(t, api.WithConcurrency(4),
+		api.WithStationGroups(map[string][]string{"G:LON": {"STP", "EUS"}}))
+	// Found by the first search of the group, but leaving between trains
+	// found by the second
+	const fromStPancras = "gb-nr:T10002:2026-01-05"
+	addService(fake, fromStPancras, "STP 08:10", "MAN 10:30")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.StreamDepartures(ctx, "G:LON", "MAN", api.SearchOptions{})
+	if err != nil {
+		t.Fatalf("StreamDepartures: %v", err)
+	}
+	stream.Request(stream.Total())
+
+	var last time.Time
+	var ids []string
+	next := 0
+	for ev := range stream.Events() {
+		if ev.Index != next {
+			t.Errorf("event for index %d arrived when %d was due", ev.Index, next)
+		}
+		next++
+		if ev.Departure == nil {
+			continue
+		}
+		if ev.Departure.ScheduledDeparture.Before(last) {
+			t.Errorf("%s leaves at %v, before the train reported ahead of it", ev.Departure.ServiceID, ev.Departure.ScheduledDeparture)
+		}
+		last = ev.Departure.ScheduledDeparture
+		ids = append(ids, ev.Departure.ServiceID)
+	}
+	if next != stream.Total() {
+		t.Errorf("got %d events, want %d", next, stream.Total())
+	}
+	if want := []string{onTime, fromStPancras, delayed, cancelled, changed}; strings.Join(ids, " ") != strings.Join(want, " ") {
+		t.Errorf("departures = %v, want %v", ids, want)
+	}
+}
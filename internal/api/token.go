@@ -0,0 +1,108 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type accessTokenResponse struct {
+	Token      string `json:"token"`
+	ValidUntil string `json:"validUntil"`
+}
+
+// tokenRefresh is a token exchange in progress. Requests that need a token
+// while one is running wait for done and share its result, rather than each
+// starting their own exchange.
+type tokenRefresh struct {
+	done   chan struct{}
+	token  string
+	expiry time.Time
+	err    error
+}
+
+// token returns a valid access token, exchanging the refresh token for a new
+// one if there is none or it has expired. Passing the token the API has just
+// rejected as stale forces a new exchange, unless another request has already
+// replaced it. It is safe to call from several goroutines at once.
+func (c *Client) token(ctx context.Context, stale string) (string, error) {
+	for {
+		c.tokenMu.Lock()
+		if c.accessToken != "" && c.accessToken != stale && time.Now().Before(c.tokenExpiry) {
+			token := c.accessToken
+			c.tokenMu.Unlock()
+			return token, nil
+		}
+
+		r := c.tokenRefresh
+		if r == nil {
+			// Nobody is exchanging the token yet, so this request does it
+			r = &tokenRefresh{done: make(chan struct{})}
+			c.tokenRefresh = r
+			c.tokenMu.Unlock()
+
+			r.token, r.expiry, r.err = c.exchangeToken(ctx)
+
+			c.tokenMu.Lock()
+			if r.err == nil {
+				c.accessToken, c.tokenExpiry = r.token, r.expiry
+			}
+			c.tokenRefresh = nil
+			c.tokenMu.Unlock()
+			close(r.done)
+			return r.token, r.err
+		}
+		c.tokenMu.Unlock()
+
+		select {
+		case <-r.done:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+
+		// If the request doing the exchange was cancelled, try again with ours
+		if errors.Is(r.err, context.Canceled) || errors.Is(r.err, context.DeadlineExceeded) {
+			continue
+		}
+		return r.token, r.err
+	}
+}
+
+// exchangeToken swaps the refresh token for a short-life access token.
+func (c *Client) exchangeToken(ctx context.Context) (string, time.Time, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+"/api/get_access_token", nil)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.refreshToken)
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to exchange token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", time.Time{}, fmt.Errorf("token exchange failed: %w", newStatusError(resp))
+	}
+
+	var tokenResp accessTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to decode token response: %w", err)
+	}
+
+	expiry, err := time.Parse(time.RFC3339, tokenResp.ValidUntil)
+	if err == nil {
+		// Refresh a bit early to avoid edge cases
+		expiry = expiry.Add(-30 * time.Second)
+	} else {
+		// If we can't parse expiry, refresh after 5 minutes
+		expiry = time.Now().Add(5 * time.Minute)
+	}
+
+	return tokenResp.Token, expiry, nil
+}
@@ -0,0 +1,93 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+	"github.com/baz-sh/rtt-cli/internal/rttfake"
+)
+
+// concurrentSearches runs n searches at once, failing the test if any fails.
+func concurrentSearches(t *testing.T, client *api.Client, n int) {
+	t.Helper()
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := client.GetDepartures(context.Background(), "EUS", "MAN", api.SearchOptions{})
+			if err == nil && len(result.Skipped) > 0 {
+				err = result.Skipped[0]
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("GetDepartures: %v", err)
+		}
+	}
+}
+
+// Run these with -race: every search shares the client's access token.
+
+func TestTokenFetchedOnceForConcurrentSearches(t *testing.T) {
+	fake, client := newTestClient(t)
+
+	concurrentSearches(t, client, 20)
+	if got := fake.TokensIssued(); got != 1 {
+		t.Errorf("TokensIssued = %d, want 1", got)
+	}
+}
+
+func TestExpiredTokenRefreshedOnceForConcurrentSearches(t *testing.T) {
+	fake, client := newTestClient(t)
+
+	concurrentSearches(t, client, 1)
+	before := fake.TokensIssued()
+
+	fake.ExpireTokens()
+	concurrentSearches(t, client, 20)
+	if got := fake.TokensIssued() - before; got != 1 {
+		t.Errorf("%d tokens issued after the old one expired, want 1", got)
+	}
+}
+
+func TestUnauthorizedRetriedOnceWithNewToken(t *testing.T) {
+	fake, client := newTestClient(t)
+	fake.FailNext(rttfake.PathLocation, http.StatusUnauthorized, 1)
+
+	result, err := client.GetDepartures(context.Background(), "EUS", "MAN", api.SearchOptions{})
+	if err != nil {
+		t.Fatalf("GetDepartures: %v", err)
+	}
+	if len(result.Departures) != 4 {
+		t.Errorf("got %d departures, want 4", len(result.Departures))
+	}
+	if got := fake.TokensIssued(); got != 2 {
+		t.Errorf("TokensIssued = %d, want 2 (one forced refresh)", got)
+	}
+	if got := fake.Requests(rttfake.PathLocation); got != 2 {
+		t.Errorf("location requests = %d, want 2", got)
+	}
+}
+
+func TestUnauthorizedTwiceFails(t *testing.T) {
+	fake, client := newTestClient(t)
+	fake.FailNext(rttfake.PathLocation, http.StatusUnauthorized, 2)
+
+	_, err := client.GetDepartures(context.Background(), "EUS", "MAN", api.SearchOptions{})
+	if !errors.Is(err, api.ErrUnauthorized) {
+		t.Fatalf("err = %v, want ErrUnauthorized", err)
+	}
+	if got := fake.TokensIssued(); got != 2 {
+		t.Errorf("TokensIssued = %d, want 2 (one forced refresh)", got)
+	}
+}
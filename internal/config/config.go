@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"syscall"
 
@@ -13,6 +14,48 @@ import (
 
 type Config struct {
 	Token string `json:"token"`
+
+	// Optional connection settings. Each can be overridden by the environment
+	// variable named alongside it.
+	BaseURL     string `json:"base_url,omitempty"`    // RTT_BASE_URL
+	Timeout     string `json:"timeout,omitempty"`     // RTT_TIMEOUT, e.g. "30s"
+	UserAgent   string `json:"user_agent,omitempty"`  // RTT_USER_AGENT
+	Concurrency int    `json:"concurrency,omitempty"` // RTT_CONCURRENCY
+	CAFile      string `json:"ca_file,omitempty"`     // RTT_CA_FILE, PEM bundle of extra trusted CAs
+
+	// Station groups to offer alongside the built-in ones.
+	Groups []Group `json:"groups,omitempty"`
+}
+
+// Group is a user-defined station group, e.g. the stations near home.
+type Group struct {
+	Code     string   `json:"code"`     // must start with "G:"
+	Name     string   `json:"name"`     // e.g. "Home (any)"
+	Stations []string `json:"stations"` // CRS codes
+}
+
+// ApplyEnv overrides the connection settings with any that are set in the
+// environment.
+func (c *Config) ApplyEnv() error {
+	for env, field := range map[string]*string{
+		"RTT_BASE_URL":   &c.BaseURL,
+		"RTT_TIMEOUT":    &c.Timeout,
+		"RTT_USER_AGENT": &c.UserAgent,
+		"RTT_CA_FILE":    &c.CAFile,
+	} {
+		if v := os.Getenv(env); v != "" {
+			*field = v
+		}
+	}
+
+	if v := os.Getenv("RTT_CONCURRENCY"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid RTT_CONCURRENCY %q: %w", v, err)
+		}
+		c.Concurrency = n
+	}
+	return nil
 }
 
 // isValid returns true if the config has the required fields.
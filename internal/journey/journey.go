@@ -0,0 +1,374 @@
+// Package journey plans trips that need one or two changes of train, by
+// joining the stopping patterns of services at the origin, the destination and
+// the most promising interchange stations in between.
+package journey
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+)
+
+const (
+	// DefaultMinConnection is the shortest change of trains the planner will
+	// suggest when no other minimum is configured.
+	DefaultMinConnection = 5 * time.Minute
+
+	// searchWindow is how far after the search time first legs may depart.
+	searchWindow = 2 * time.Hour
+
+	// arrivalWindow is how far after the search time final legs may arrive.
+	arrivalWindow = 3 * time.Hour
+
+	// maxInterchanges limits how many intermediate stations are explored for
+	// two-change journeys, as each one costs a location lookup.
+	maxInterchanges = 5
+
+	// maxItineraries is the most itineraries returned from a single search.
+	maxItineraries = 10
+)
+
+// Options configures a journey search.
+type Options struct {
+	At            time.Time     // earliest departure, zero means now
+	MinConnection time.Duration // shortest allowed change, DefaultMinConnection if zero
+	MaxChanges    int           // 0 for direct trains only, at most 2
+}
+
+// Stop is where a leg of a journey boards or alights.
+type Stop struct {
+	Name     string
+	Code     string
+	Time     time.Time // booked departure (boarding) or arrival (alighting)
+	Platform string
+}
+
+// Leg is a single train ridden as part of an itinerary.
+type Leg struct {
+	ServiceID   string
+	Operator    string
+	Destination string // where the train itself terminates
+	Board       Stop
+	Alight      Stop
+}
+
+// Duration returns how long is spent on the train.
+func (l Leg) Duration() time.Duration {
+	return l.Alight.Time.Sub(l.Board.Time)
+}
+
+// Itinerary is a complete journey from origin to destination.
+type Itinerary struct {
+	Legs []Leg
+}
+
+// Departs returns the time the first train leaves.
+func (it Itinerary) Departs() time.Time {
+	return it.Legs[0].Board.Time
+}
+
+// Arrives returns the time the last train reaches the destination.
+func (it Itinerary) Arrives() time.Time {
+	return it.Legs[len(it.Legs)-1].Alight.Time
+}
+
+// Duration returns the total journey time, including waits between trains.
+func (it Itinerary) Duration() time.Duration {
+	return it.Arrives().Sub(it.Departs())
+}
+
+// Changes returns the number of times the passenger changes train.
+func (it Itinerary) Changes() int {
+	return len(it.Legs) - 1
+}
+
+// Wait returns how long is spent at the interchange after leg i.
+func (it Itinerary) Wait(i int) time.Duration {
+	if i+1 >= len(it.Legs) {
+		return 0
+	}
+	return it.Legs[i+1].Board.Time.Sub(it.Legs[i].Alight.Time)
+}
+
+// key identifies an itinerary by the trains it uses and where it changes.
+func (it Itinerary) key() string {
+	parts := make([]string, len(it.Legs))
+	for i, leg := range it.Legs {
+		parts[i] = leg.ServiceID + "@" + leg.Board.Code + ">" + leg.Alight.Code
+	}
+	return strings.Join(parts, "|")
+}
+
+// Planner searches for journeys using live timetable data.
+type Planner struct {
+	client *api.Client
+}
+
+// NewPlanner creates a planner that fetches services with the given client.
+func NewPlanner(client *api.Client) *Planner {
+	return &Planner{client: client}
+}
+
+// LimiterStatus reports whether the planner's requests are being held back
+// by the API's rate limit.
+func (p *Planner) LimiterStatus() api.LimiterStatus {
+	return p.client.LimiterStatus()
+}
+
+// Plan finds journeys from one station to another, ranked by arrival time.
+// Direct trains are always included; journeys with changes are searched for
+// up to opts.MaxChanges. Two-change journeys are only explored when there are
+// too few direct and one-change options, since they need many more lookups.
+func (p *Planner) Plan(ctx context.Context, from, to string, opts Options) ([]Itinerary, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if from == to {
+		return nil, fmt.Errorf("origin and destination are the same station")
+	}
+	if opts.MaxChanges < 0 || opts.MaxChanges > 2 {
+		return nil, fmt.Errorf("max changes must be between 0 and 2")
+	}
+	if opts.MinConnection <= 0 {
+		opts.MinConnection = DefaultMinConnection
+	}
+	at := opts.At
+	if at.IsZero() {
+		at = p.client.Now()
+	}
+
+	outbound, err := p.client.GetServices(ctx, from, at, searchWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []Itinerary
+	for _, svc := range outbound {
+		if leg, ok := ride(svc, from, to, at); ok {
+			found = append(found, Itinerary{Legs: []Leg{leg}})
+		}
+	}
+
+	if opts.MaxChanges >= 1 {
+		inbound, err := p.client.GetServices(ctx, to, at, arrivalWindow)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, first := range outbound {
+			for _, leg := range onwardLegs(first, from, at) {
+				if leg.Alight.Code == to {
+					continue
+				}
+				for _, last := range inbound {
+					if last.ID == first.ID {
+						continue
+					}
+					next, ok := ride(last, leg.Alight.Code, to, leg.Alight.Time.Add(opts.MinConnection))
+					if ok {
+						found = append(found, Itinerary{Legs: []Leg{leg, next}})
+					}
+				}
+			}
+		}
+
+		if opts.MaxChanges >= 2 && len(prune(found)) < 3 {
+			more, err := p.twoChanges(ctx, from, to, outbound, inbound, at, opts.MinConnection)
+			if err != nil {
+				return nil, err
+			}
+			found = append(found, more...)
+		}
+	}
+
+	itineraries := prune(found)
+	if len(itineraries) > maxItineraries {
+		itineraries = itineraries[:maxItineraries]
+	}
+	return itineraries, nil
+}
+
+// twoChanges joins the first and last trains with a middle train between an
+// interchange reachable from the origin and one served by trains to the
+// destination. Only the interchanges most frequently called at by trains from
+// the origin are explored.
+func (p *Planner) twoChanges(ctx context.Context, from, to string, outbound, inbound []api.ServiceDetail, at time.Time, minConnection time.Duration) ([]Itinerary, error) {
+	// Earliest arrival at each station reachable directly from the origin
+	firstLegs := map[string][]Leg{}
+	frequency := map[string]int{}
+	for _, svc := range outbound {
+		for _, leg := range onwardLegs(svc, from, at) {
+			if leg.Alight.Code == to {
+				continue
+			}
+			firstLegs[leg.Alight.Code] = append(firstLegs[leg.Alight.Code], leg)
+			frequency[leg.Alight.Code]++
+		}
+	}
+
+	// Stations served by trains that go on to the destination
+	feeders := map[string]bool{}
+	for _, svc := range inbound {
+		idx := svc.Index(to)
+		for i := 0; i < idx; i++ {
+			feeders[strings.ToUpper(svc.CallingPoints[i].Code)] = true
+		}
+	}
+
+	var candidates []string
+	for code := range firstLegs {
+		if code != from && !feeders[code] {
+			candidates = append(candidates, code)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if frequency[candidates[i]] != frequency[candidates[j]] {
+			return frequency[candidates[i]] > frequency[candidates[j]]
+		}
+		return candidates[i] < candidates[j]
+	})
+	if len(candidates) > maxInterchanges {
+		candidates = candidates[:maxInterchanges]
+	}
+
+	var found []Itinerary
+	for _, code := range candidates {
+		legs := firstLegs[code]
+		earliest := legs[0].Alight.Time
+		for _, leg := range legs {
+			if leg.Alight.Time.Before(earliest) {
+				earliest = leg.Alight.Time
+			}
+		}
+
+		middle, err := p.client.GetServices(ctx, code, earliest.Add(minConnection), searchWindow)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if err != nil {
+			// An unavailable interchange just means fewer suggestions
+			continue
+		}
+
+		for _, first := range legs {
+			for _, svc := range middle {
+				if svc.ID == first.ServiceID {
+					continue
+				}
+				for _, second := range onwardLegs(svc, code, first.Alight.Time.Add(minConnection)) {
+					if !feeders[second.Alight.Code] || second.Alight.Code == from {
+						continue
+					}
+					for _, last := range inbound {
+						if last.ID == svc.ID || last.ID == first.ServiceID {
+							continue
+						}
+						third, ok := ride(last, second.Alight.Code, to, second.Alight.Time.Add(minConnection))
+						if ok {
+							found = append(found, Itinerary{Legs: []Leg{first, second, third}})
+						}
+					}
+				}
+			}
+		}
+	}
+	return found, nil
+}
+
+// ride returns the leg travelled on svc between two stations, if the train
+// calls at both in that order, leaves no earlier than notBefore and neither
+// stop is cancelled.
+func ride(svc api.ServiceDetail, from, to string, notBefore time.Time) (Leg, bool) {
+	i := svc.Index(from)
+	if i < 0 {
+		return Leg{}, false
+	}
+	for j := i + 1; j < len(svc.CallingPoints); j++ {
+		if strings.EqualFold(svc.CallingPoints[j].Code, to) {
+			return makeLeg(svc, i, j, notBefore)
+		}
+	}
+	return Leg{}, false
+}
+
+// onwardLegs returns a leg from the given station to every later stop of svc.
+func onwardLegs(svc api.ServiceDetail, from string, notBefore time.Time) []Leg {
+	i := svc.Index(from)
+	if i < 0 {
+		return nil
+	}
+	var legs []Leg
+	for j := i + 1; j < len(svc.CallingPoints); j++ {
+		if leg, ok := makeLeg(svc, i, j, notBefore); ok {
+			legs = append(legs, leg)
+		}
+	}
+	return legs
+}
+
+func makeLeg(svc api.ServiceDetail, i, j int, notBefore time.Time) (Leg, bool) {
+	board, alight := svc.CallingPoints[i], svc.CallingPoints[j]
+	if board.Cancelled || alight.Cancelled || board.Code == "" || alight.Code == "" {
+		return Leg{}, false
+	}
+	if board.BookedDeparture.IsZero() || alight.BookedArrival.IsZero() {
+		return Leg{}, false
+	}
+	if board.BookedDeparture.Before(notBefore) {
+		return Leg{}, false
+	}
+	return Leg{
+		ServiceID:   svc.ID,
+		Operator:    svc.Operator,
+		Destination: svc.Destination().Name,
+		Board: Stop{
+			Name:     board.Name,
+			Code:     strings.ToUpper(board.Code),
+			Time:     board.BookedDeparture,
+			Platform: board.Platform,
+		},
+		Alight: Stop{
+			Name:     alight.Name,
+			Code:     strings.ToUpper(alight.Code),
+			Time:     alight.BookedArrival,
+			Platform: alight.Platform,
+		},
+	}, true
+}
+
+// prune sorts itineraries by arrival and drops any that another itinerary
+// beats outright: one leaving no earlier, arriving no later and changing no
+// more often.
+func prune(found []Itinerary) []Itinerary {
+	sort.SliceStable(found, func(i, j int) bool {
+		a, b := found[i], found[j]
+		if !a.Arrives().Equal(b.Arrives()) {
+			return a.Arrives().Before(b.Arrives())
+		}
+		if a.Changes() != b.Changes() {
+			return a.Changes() < b.Changes()
+		}
+		if !a.Departs().Equal(b.Departs()) {
+			return a.Departs().After(b.Departs())
+		}
+		return a.key() < b.key()
+	})
+
+	var kept []Itinerary
+	for _, it := range found {
+		dominated := false
+		for _, other := range kept {
+			if !other.Departs().Before(it.Departs()) && !other.Arrives().After(it.Arrives()) &&
+				other.Changes() <= it.Changes() {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			kept = append(kept, it)
+		}
+	}
+	return kept
+}
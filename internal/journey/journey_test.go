@@ -0,0 +1,207 @@
+package journey
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+	"github.com/baz-sh/rtt-cli/internal/rttfake"
+)
+
+// stop is a call in a test service; an empty time means the service only
+// arrives (at its last stop) or only departs (from its first).
+type stop struct {
+	code     string
+	arr, dep string // "15:04" on the test day
+}
+
+// clock returns a time on the test day, a Monday in the fixtures' week.
+func clock(hhmm string) time.Time {
+	t, err := time.Parse("15:04", hhmm)
+	if err != nil {
+		panic(err)
+	}
+	return time.Date(2026, 1, 5, t.Hour(), t.Minute(), 0, 0, time.Local)
+}
+
+// service builds a passenger train calling at the given stops.
+func service(identity string, stops ...stop) rttfake.Service {
+	svc := rttfake.Service{
+		ScheduleMetadata: rttfake.ScheduleMetadata{
+			UniqueIdentity:     "gb-nr:" + identity + ":2026-01-05",
+			Operator:           rttfake.Operator{Name: "Test Trains"},
+			InPassengerService: true,
+		},
+	}
+	for _, s := range stops {
+		loc := rttfake.Location{Location: rttfake.Place{Description: "Station " + s.code, ShortCodes: []string{s.code}}}
+		if s.arr != "" {
+			loc.TemporalData.Arrival = &rttfake.Times{ScheduleAdvertised: clock(s.arr).Format(time.RFC3339)}
+		}
+		if s.dep != "" {
+			loc.TemporalData.Departure = &rttfake.Times{ScheduleAdvertised: clock(s.dep).Format(time.RFC3339)}
+		}
+		svc.Locations = append(svc.Locations, loc)
+	}
+	return svc
+}
+
+// newTestPlanner serves the given services and returns a planner that uses
+// them.
+func newTestPlanner(t *testing.T, services ...rttfake.Service) *Planner {
+	t.Helper()
+	fake := rttfake.New("")
+	for _, svc := range services {
+		fake.AddService(svc)
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	return NewPlanner(api.NewClient("test", api.WithBaseURL(srv.URL), api.WithRateLimit(0, 0)))
+}
+
+// describe summarises an itinerary as its trains and where they are boarded
+// and left, such as "S1 AAA-BBB, S2 BBB-ZZZ".
+func describe(it Itinerary) string {
+	parts := make([]string, len(it.Legs))
+	for i, leg := range it.Legs {
+		identity := strings.Split(leg.ServiceID, ":")[1]
+		parts[i] = fmt.Sprintf("%s %s-%s", identity, leg.Board.Code, leg.Alight.Code)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func plan(t *testing.T, p *Planner, opts Options) []string {
+	t.Helper()
+	opts.At = clock("09:00")
+	found, err := p.Plan(context.Background(), "AAA", "ZZZ", opts)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	var got []string
+	for _, it := range found {
+		got = append(got, describe(it))
+	}
+	return got
+}
+
+func TestPlanDirectAndOneChange(t *testing.T) {
+	p := newTestPlanner(t,
+		service("D1", stop{"AAA", "", "09:10"}, stop{"ZZZ", "11:00", ""}),
+		// Leaves earlier than D1 but arrives later, so D1 beats it
+		service("D2", stop{"AAA", "", "09:02"}, stop{"ZZZ", "11:05", ""}),
+		service("S1", stop{"AAA", "", "09:05"}, stop{"BBB", "09:30", "09:31"}, stop{"YYY", "09:50", ""}),
+		service("S2", stop{"BBB", "", "09:40"}, stop{"ZZZ", "10:30", ""}),
+		// Only three minutes after S1 reaches BBB
+		service("S3", stop{"BBB", "", "09:33"}, stop{"ZZZ", "10:15", ""}),
+	)
+
+	tests := []struct {
+		name string
+		opts Options
+		want []string
+	}{
+		{"direct only", Options{}, []string{"D1 AAA-ZZZ"}},
+		{"one change, default connection", Options{MaxChanges: 1},
+			[]string{"S1 AAA-BBB, S2 BBB-ZZZ", "D1 AAA-ZZZ"}},
+		// The faster change makes the slower one pointless
+		{"one change, short connection", Options{MaxChanges: 1, MinConnection: 2 * time.Minute},
+			[]string{"S1 AAA-BBB, S3 BBB-ZZZ", "D1 AAA-ZZZ"}},
+		{"one change, long connection", Options{MaxChanges: 1, MinConnection: 15 * time.Minute},
+			[]string{"D1 AAA-ZZZ"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := plan(t, p, tt.opts); !slices.Equal(got, tt.want) {
+				t.Errorf("Plan = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanTwoChanges(t *testing.T) {
+	p := newTestPlanner(t,
+		service("T1", stop{"AAA", "", "09:05"}, stop{"CCC", "09:30", ""}),
+		service("T2", stop{"CCC", "", "09:40"}, stop{"DDD", "10:00", ""}),
+		service("T3", stop{"DDD", "", "10:10"}, stop{"ZZZ", "10:40", ""}),
+		// Too soon after T2 reaches DDD
+		service("T4", stop{"DDD", "", "10:02"}, stop{"ZZZ", "10:30", ""}),
+		// Later than T3 from the same connection
+		service("T5", stop{"DDD", "", "10:20"}, stop{"ZZZ", "10:50", ""}),
+	)
+
+	tests := []struct {
+		name string
+		opts Options
+		want []string
+	}{
+		{"one change at most", Options{MaxChanges: 1}, nil},
+		{"two changes", Options{MaxChanges: 2}, []string{"T1 AAA-CCC, T2 CCC-DDD, T3 DDD-ZZZ"}},
+		{"two changes, short connections", Options{MaxChanges: 2, MinConnection: 2 * time.Minute},
+			[]string{"T1 AAA-CCC, T2 CCC-DDD, T4 DDD-ZZZ"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := plan(t, p, tt.opts); !slices.Equal(got, tt.want) {
+				t.Errorf("Plan = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanMinConnection(t *testing.T) {
+	p := newTestPlanner(t,
+		service("M1", stop{"AAA", "", "09:05"}, stop{"BBB", "09:30", ""}),
+		service("M2", stop{"BBB", "", "09:35"}, stop{"ZZZ", "10:00", ""}),
+	)
+
+	for _, tt := range []struct {
+		minConnection time.Duration
+		connects      bool
+	}{
+		{time.Minute, true},
+		{5 * time.Minute, true}, // exactly the minimum
+		{6 * time.Minute, false},
+	} {
+		got := plan(t, p, Options{MaxChanges: 1, MinConnection: tt.minConnection})
+		if connects := len(got) == 1; connects != tt.connects {
+			t.Errorf("with a %v minimum connection, Plan = %q, want a connection: %v", tt.minConnection, got, tt.connects)
+		}
+		for _, it := range got {
+			if !strings.Contains(it, "M1") || !strings.Contains(it, "M2") {
+				t.Errorf("with a %v minimum connection, Plan = %q", tt.minConnection, got)
+			}
+		}
+	}
+}
+
+func TestPrune(t *testing.T) {
+	leg := func(id, dep, arr string) Leg {
+		return Leg{ServiceID: "gb-nr:" + id + ":2026-01-05", Board: Stop{Code: "AAA", Time: clock(dep)}, Alight: Stop{Code: "ZZZ", Time: clock(arr)}}
+	}
+	changeAt := func(first, second Leg) Itinerary {
+		first.Alight.Code, second.Board.Code = "BBB", "BBB"
+		return Itinerary{Legs: []Leg{first, second}}
+	}
+	found := []Itinerary{
+		{Legs: []Leg{leg("A", "09:00", "10:00")}},
+		{Legs: []Leg{leg("B", "08:50", "10:05")}},                        // leaves earlier, arrives later: beaten by A
+		{Legs: []Leg{leg("C", "09:10", "10:20")}},                        // leaves later: kept
+		changeAt(leg("D", "09:00", "09:20"), leg("E", "09:30", "09:55")), // arrives sooner with a change: kept
+		changeAt(leg("F", "09:00", "09:20"), leg("G", "09:40", "10:00")), // same times as A with a change: beaten
+		changeAt(leg("H", "09:15", "09:30"), leg("I", "09:40", "10:20")), // leaves latest: kept
+	}
+
+	var got []string
+	for _, it := range prune(found) {
+		got = append(got, describe(it))
+	}
+	want := []string{"D AAA-BBB, E BBB-ZZZ", "A AAA-ZZZ", "C AAA-ZZZ", "H AAA-BBB, I BBB-ZZZ"}
+	if !slices.Equal(got, want) {
+		t.Errorf("prune = %q, want %q", got, want)
+	}
+}
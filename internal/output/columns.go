@@ -0,0 +1,127 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+	"github.com/baz-sh/rtt-cli/internal/stations"
+)
+
+// Column is a field of a departure that can be shown in tabular output.
+type Column struct {
+	Key    string // name used with --columns
+	Header string
+	Value  func(api.Departure) string
+}
+
+// Columns is the full set of departure columns, in default display order.
+// It is shared by the interactive tables and the plain-text renderers.
+var Columns = []Column{
+	{Key: "time", Header: "Time", Value: func(d api.Departure) string { return d.BookedDepartureTime }},
+	{Key: "expected", Header: "Expected", Value: ExpectedStatus},
+	{Key: "leaving", Header: "Leaving", Value: func(d api.Departure) string { return d.Leaving }},
+	{Key: "dep-plat", Header: "Dep Plat", Value: func(d api.Departure) string { return d.DeparturePlatform }},
+	{Key: "arr-plat", Header: "Arr Plat", Value: func(d api.Departure) string { return d.Platform }},
+	{Key: "service", Header: "Service", Value: func(d api.Departure) string { return d.Service }},
+	{Key: "duration", Header: "Duration", Value: func(d api.Departure) string { return d.Duration }},
+	{Key: "calls-at", Header: "Calls at", Value: func(d api.Departure) string { return strings.Join(d.CallsAt, ", ") }},
+	{Key: "from", Header: "From", Value: func(d api.Departure) string { return d.FromName }},
+	{Key: "to", Header: "To", Value: func(d api.Departure) string { return d.ToName }},
+}
+
+// DefaultColumns returns the columns shown when none are chosen with
+// --columns for a search between from and to. The Calls at column is only
+// included for searches via other stations, and the From and To columns only
+// for searches from or to a station group, as they say nothing new otherwise.
+func DefaultColumns(from, to string, search api.SearchOptions) []Column {
+	var cols []Column
+	for _, col := range Columns {
+		switch {
+		case col.Key == "calls-at" && len(search.Via) == 0,
+			col.Key == "from" && !stations.IsGroup(from),
+			col.Key == "to" && !stations.IsGroup(to):
+			continue
+		}
+		cols = append(cols, col)
+	}
+	return cols
+}
+
+// columnAliases maps alternative --columns names to column keys.
+var columnAliases = map[string]string{
+	"plat":     "dep-plat",
+	"platform": "dep-plat",
+	"arr":      "arr-plat",
+	"operator": "service",
+	"via":      "calls-at",
+}
+
+// ParseColumns parses a comma-separated list of column keys, e.g.
+// "time,plat,service". An empty spec returns nil, meaning the default columns.
+func ParseColumns(spec string) ([]Column, error) {
+	if strings.TrimSpace(spec) == "" {
+		return nil, nil
+	}
+
+	var cols []Column
+	for _, key := range strings.Split(spec, ",") {
+		key = strings.ToLower(strings.TrimSpace(key))
+		if alias, ok := columnAliases[key]; ok {
+			key = alias
+		}
+		col, ok := columnByKey(key)
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q: use %s", key, strings.Join(columnKeys(), ", "))
+		}
+		cols = append(cols, col)
+	}
+	return cols, nil
+}
+
+func columnByKey(key string) (Column, bool) {
+	for _, col := range Columns {
+		if col.Key == key {
+			return col, true
+		}
+	}
+	return Column{}, false
+}
+
+func columnKeys() []string {
+	keys := make([]string, len(Columns))
+	for i, col := range Columns {
+		keys[i] = col.Key
+	}
+	return keys
+}
+
+// ExpectedStatus describes how a departure is running, e.g. "On time",
+// "14:37 (+7)" or "Cancelled". Returns an empty string when there is no realtime data.
+func ExpectedStatus(dep api.Departure) string {
+	return runningStatus(dep.Cancelled, dep.ExpectedDepartureTime, dep.LateMinutes)
+}
+
+// BoardStatus is ExpectedStatus for an entry on a station board.
+func BoardStatus(entry api.BoardEntry) string {
+	var expected string
+	if !entry.ExpectedTime.IsZero() {
+		expected = entry.ExpectedTime.Format("15:04")
+	}
+	return runningStatus(entry.Cancelled, expected, entry.LateMinutes)
+}
+
+// runningStatus formats a train's status from its expected time as "15:04",
+// which is empty when there is no realtime data.
+func runningStatus(cancelled bool, expected string, lateMinutes int) string {
+	if cancelled {
+		return "Cancelled"
+	}
+	if expected == "" {
+		return ""
+	}
+	if lateMinutes == 0 {
+		return "On time"
+	}
+	return fmt.Sprintf("%s (%+d)", expected, lateMinutes)
+}
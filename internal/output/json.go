@@ -0,0 +1,107 @@
+// Package output renders search results for non-interactive use, such as
+// scripts, cron jobs and pipes.
+package output
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+)
+
+// Station identifies one end of a search in JSON output.
+type Station struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+// Document is the top-level JSON object written by WriteJSON.
+//
+// The field names and types below are a stable, documented format. Times are
+// RFC 3339 timestamps with a UTC offset. Realtime fields are omitted when the
+// API has no realtime data for a service.
+type Document struct {
+	From        Station     `json:"from"`
+	To          Station     `json:"to"`
+	GeneratedAt time.Time   `json:"generated_at"`
+	Departures  []Departure `json:"departures"`
+	Skipped     []Skipped   `json:"skipped,omitempty"`
+}
+
+// Departure is the JSON representation of an api.Departure.
+type Departure struct {
+	ServiceID          string    `json:"service_id"`
+	Operator           string    `json:"operator"`
+	ScheduledDeparture time.Time `json:"scheduled_departure"`
+	ExpectedDeparture  time.Time `json:"expected_departure,omitzero"`
+	ScheduledArrival   time.Time `json:"scheduled_arrival,omitzero"`
+	ExpectedArrival    time.Time `json:"expected_arrival,omitzero"`
+	DeparturePlatform  string    `json:"departure_platform,omitempty"`
+	ArrivalPlatform    string    `json:"arrival_platform,omitempty"`
+	DurationMinutes    int       `json:"duration_minutes"`
+	LateMinutes        int       `json:"late_minutes"`
+	Cancelled          bool      `json:"cancelled"`
+	CancellationReason string    `json:"cancellation_reason,omitempty"`
+	CallsAt            []string  `json:"calls_at,omitempty"`
+	DepartsFrom        Station   `json:"departs_from"`
+	ArrivesAt          Station   `json:"arrives_at"`
+}
+
+// Skipped is a service that was found but whose details could not be loaded,
+// so it is missing from the departures.
+type Skipped struct {
+	ServiceID          string    `json:"service_id"`
+	Operator           string    `json:"operator"`
+	ScheduledDeparture time.Time `json:"scheduled_departure,omitzero"`
+	Error              string    `json:"error"`
+}
+
+// NewDocument converts a search result into its JSON representation.
+func NewDocument(from, to Station, result api.DepartureResult) Document {
+	doc := Document{
+		From:        from,
+		To:          to,
+		GeneratedAt: time.Now(),
+		Departures:  make([]Departure, 0, len(result.Departures)),
+	}
+	for _, skipped := range result.Skipped {
+		doc.Skipped = append(doc.Skipped, Skipped{
+			ServiceID:          skipped.ServiceID,
+			Operator:           skipped.Operator,
+			ScheduledDeparture: skipped.ScheduledDeparture,
+			Error:              skipped.Err.Error(),
+		})
+	}
+	for _, dep := range result.Departures {
+		duration := 0
+		if !dep.ScheduledArrival.IsZero() {
+			duration = int(dep.ScheduledArrival.Sub(dep.ScheduledDeparture).Minutes())
+		}
+		doc.Departures = append(doc.Departures, Departure{
+			ServiceID:          dep.ServiceID,
+			Operator:           dep.Service,
+			ScheduledDeparture: dep.ScheduledDeparture,
+			ExpectedDeparture:  dep.ExpectedDeparture,
+			ScheduledArrival:   dep.ScheduledArrival,
+			ExpectedArrival:    dep.ExpectedArrival,
+			DeparturePlatform:  dep.DeparturePlatform,
+			ArrivalPlatform:    dep.Platform,
+			DurationMinutes:    duration,
+			LateMinutes:        dep.LateMinutes,
+			Cancelled:          dep.Cancelled,
+			CancellationReason: dep.CancellationReason,
+			CallsAt:            dep.CallsAt,
+			DepartsFrom:        Station{Code: dep.From, Name: dep.FromName},
+			ArrivesAt:          Station{Code: dep.To, Name: dep.ToName},
+		})
+	}
+	return doc
+}
+
+// WriteJSON writes a search result as an indented JSON Document.
+func WriteJSON(w io.Writer, from, to Station, result api.DepartureResult) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(NewDocument(from, to, result))
+}
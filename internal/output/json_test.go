@@ -0,0 +1,102 @@
+package output
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// golden compares got with testdata/name, or rewrites it with -update.
+func golden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run go test -update to create it)", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s differs from the golden file:\n--- got\n%s\n--- want\n%s", name, got, want)
+	}
+}
+
+func at(hh, mm int) time.Time {
+	return time.Date(2026, 1, 5, hh, mm, 0, 0, time.UTC)
+}
+
+// testResult has an on-time train, a late one, a cancellation, one without
+// realtime data, and values holding the separators each format must escape.
+var testResult = api.DepartureResult{
+	Departures: []api.Departure{
+		{
+			ServiceID: "gb-nr:P10001:2026-01-05", BookedDepartureTime: "09:00", ExpectedDepartureTime: "09:00",
+			DeparturePlatform: "15", Platform: "6", Duration: "2h 7m", Leaving: "in 5 min", Service: "Avanti West Coast",
+			CallsAt: []string{"Stockport"}, From: "EUS", FromName: "London Euston", To: "MAN", ToName: "Manchester Piccadilly",
+			ScheduledDeparture: at(9, 0), ExpectedDeparture: at(9, 0), ScheduledArrival: at(11, 7), ExpectedArrival: at(11, 7),
+		},
+		{
+			ServiceID: "gb-nr:P10002:2026-01-05", BookedDepartureTime: "09:20", ExpectedDepartureTime: "09:27", LateMinutes: 7,
+			DeparturePlatform: "14", Duration: "2h 8m", Leaving: "in 32 min", Service: "Avanti West Coast",
+			CallsAt: []string{"Crewe", "Stockport"}, From: "EUS", FromName: "London Euston", To: "MAN", ToName: "Manchester Piccadilly",
+			ScheduledDeparture: at(9, 20), ExpectedDeparture: at(9, 27), ScheduledArrival: at(11, 28), ExpectedArrival: at(11, 35),
+		},
+		{
+			ServiceID: "gb-nr:P10003:2026-01-05", BookedDepartureTime: "09:40", Cancelled: true,
+			CancellationReason: "a fault with the signalling system, \"near Rugby\"", Duration: "2h 7m", Leaving: "in 45 min",
+			Service: "Avanti West Coast", From: "EUS", FromName: "London Euston", To: "MAN", ToName: "Manchester Piccadilly",
+			ScheduledDeparture: at(9, 40), ScheduledArrival: at(11, 47),
+		},
+		{
+			ServiceID: "gb-nr:5A001:2026-01-05", BookedDepartureTime: "10:05", Duration: "3h 1m", Leaving: "in 1h 10m",
+			Service: "Operator, with a comma\tand a tab | and a pipe", From: "EUS", FromName: "London Euston",
+			To: "MAN", ToName: "Manchester Piccadilly", ScheduledDeparture: at(10, 5), ScheduledArrival: at(13, 6),
+		},
+	},
+	Skipped: []api.ServiceError{
+		{ServiceID: "gb-nr:P10008:2026-01-05", ScheduledDeparture: at(9, 50), Operator: "London Northwestern Railway", Err: errors.New("service details unavailable")},
+	},
+}
+
+// generatedAt matches the one field of a JSON document that changes from run
+// to run.
+var generatedAt = regexp.MustCompile(`"generated_at": "[^"]*"`)
+
+func TestWriteJSON(t *testing.T) {
+	var b bytes.Buffer
+	from, to := Station{Code: "EUS", Name: "London Euston"}, Station{Code: "MAN", Name: "Manchester Piccadilly"}
+	if err := WriteJSON(&b, from, to, testResult); err != nil {
+		t.Fatal(err)
+	}
+	if !generatedAt.Match(b.Bytes()) {
+		t.Fatalf("no generated_at in:\n%s", b.Bytes())
+	}
+	golden(t, "departures.json", generatedAt.ReplaceAll(b.Bytes(), []byte(`"generated_at": "2026-01-05T08:55:00Z"`)))
+}
+
+func TestWriteJSONEmpty(t *testing.T) {
+	var b bytes.Buffer
+	if err := WriteJSON(&b, Station{Code: "EUS"}, Station{Code: "MAN"}, api.DepartureResult{}); err != nil {
+		t.Fatal(err)
+	}
+	// Scripts can always range over departures
+	if !bytes.Contains(b.Bytes(), []byte(`"departures": []`)) {
+		t.Errorf("an empty result should have an empty departures list:\n%s", b.Bytes())
+	}
+	if bytes.Contains(b.Bytes(), []byte(`"skipped"`)) {
+		t.Errorf("an empty result should have no skipped list:\n%s", b.Bytes())
+	}
+}
@@ -0,0 +1,133 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+)
+
+// Table formats accepted by WriteTable.
+const (
+	FormatText     = "text"
+	FormatCSV      = "csv"
+	FormatTSV      = "tsv"
+	FormatMarkdown = "markdown"
+)
+
+// WriteTable writes departures as a plain table with no terminal escape codes.
+func WriteTable(w io.Writer, format string, cols []Column, departures []api.Departure) error {
+	headers := make([]string, len(cols))
+	for i, col := range cols {
+		headers[i] = col.Header
+	}
+
+	rows := make([][]string, len(departures))
+	for i, dep := range departures {
+		rows[i] = make([]string, len(cols))
+		for j, col := range cols {
+			rows[i][j] = col.Value(dep)
+		}
+	}
+
+	switch format {
+	case FormatText:
+		return writeText(w, headers, rows)
+	case FormatCSV:
+		return writeCSV(w, headers, rows)
+	case FormatTSV:
+		return writeTSV(w, headers, rows)
+	case FormatMarkdown:
+		return writeMarkdown(w, headers, rows)
+	}
+	return fmt.Errorf("unknown table format %q", format)
+}
+
+// flatten replaces tabs and line breaks inside values with spaces, for
+// formats that use them to separate cells and rows.
+var flatten = strings.NewReplacer("\t", " ", "\n", " ", "\r", " ")
+
+// writeText writes space-aligned columns for reading in logs and chat messages.
+func writeText(w io.Writer, headers []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	writeRow := func(row []string) {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = flatten.Replace(cell)
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+
+	writeRow(headers)
+	for _, row := range rows {
+		writeRow(row)
+	}
+	return tw.Flush()
+}
+
+func writeCSV(w io.Writer, headers []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(headers); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// writeTSV writes tab-separated values. Tabs and newlines inside values are
+// replaced with spaces, as TSV has no quoting.
+func writeTSV(w io.Writer, headers []string, rows [][]string) error {
+	writeRow := func(row []string) error {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = flatten.Replace(cell)
+		}
+		_, err := fmt.Fprintln(w, strings.Join(cells, "\t"))
+		return err
+	}
+
+	if err := writeRow(headers); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := writeRow(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeMarkdown writes a GitHub-flavoured Markdown table.
+func writeMarkdown(w io.Writer, headers []string, rows [][]string) error {
+	escape := strings.NewReplacer("|", `\|`, "\n", " ")
+	writeRow := func(row []string) error {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = escape.Replace(cell)
+		}
+		_, err := fmt.Fprintf(w, "| %s |\n", strings.Join(cells, " | "))
+		return err
+	}
+
+	if err := writeRow(headers); err != nil {
+		return err
+	}
+	divider := make([]string, len(headers))
+	for i := range divider {
+		divider[i] = "---"
+	}
+	if err := writeRow(divider); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := writeRow(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
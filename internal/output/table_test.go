@@ -0,0 +1,31 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteTable(t *testing.T) {
+	for _, tt := range []struct {
+		format, golden string
+	}{
+		{FormatText, "departures.txt"},
+		{FormatCSV, "departures.csv"},
+		{FormatTSV, "departures.tsv"},
+		{FormatMarkdown, "departures.md"},
+	} {
+		t.Run(tt.format, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := WriteTable(&b, tt.format, Columns, testResult.Departures); err != nil {
+				t.Fatal(err)
+			}
+			golden(t, tt.golden, b.Bytes())
+		})
+	}
+}
+
+func TestWriteTableUnknownFormat(t *testing.T) {
+	if err := WriteTable(&bytes.Buffer{}, "yaml", Columns, nil); err == nil {
+		t.Error("WriteTable accepted an unknown format")
+	}
+}
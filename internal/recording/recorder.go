@@ -0,0 +1,87 @@
+package recording
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Recorder is an http.RoundTripper that passes requests on and saves every
+// exchange to a session directory.
+type Recorder struct {
+	dir  string
+	next http.RoundTripper
+
+	mu sync.Mutex
+	n  int
+}
+
+// NewRecorder starts a session in dir, creating it if needed, and records
+// requests sent through next (http.DefaultTransport if nil). The directory
+// must not already hold a session.
+func NewRecorder(dir string, next http.RoundTripper) (*Recorder, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create recording directory: %w", err)
+	}
+	if _, err := os.Stat(sessionPath(dir)); err == nil {
+		return nil, fmt.Errorf("%s already holds a recording", dir)
+	}
+	if err := writeJSON(sessionPath(dir), session{RecordedAt: time.Now()}); err != nil {
+		return nil, fmt.Errorf("failed to start recording: %w", err)
+	}
+	return &Recorder{dir: dir, next: next}, nil
+}
+
+// RoundTrip sends the request and records the response. Requests that fail
+// without a response are not recorded.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	ex := exchange{
+		Method:      req.Method,
+		URL:         req.URL.RequestURI(),
+		Status:      resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+		Header:      recordedHeader(resp.Header, time.Now()),
+	}
+	saved := body
+	if req.URL.Path == tokenPath {
+		saved = redactToken(body)
+	}
+	if json.Valid(saved) {
+		ex.Body = saved
+	} else {
+		ex.BodyText = string(saved)
+	}
+
+	if err := r.save(ex); err != nil {
+		return nil, fmt.Errorf("failed to record response: %w", err)
+	}
+	return resp, nil
+}
+
+func (r *Recorder) save(ex exchange) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.n++
+	return writeJSON(filepath.Join(r.dir, exchangeFile(r.n)), ex)
+}
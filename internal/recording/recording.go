@@ -0,0 +1,124 @@
+// Package recording captures API traffic to a directory and serves it back,
+// so a session that showed odd results can be replayed exactly, attached to a
+// bug report or turned into a regression fixture.
+//
+// A session directory holds session.json, describing when it was recorded,
+// and one numbered file per request/response exchange. Access tokens and the
+// Authorization header are never written to disk.
+package recording
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+const (
+	sessionFile = "session.json"
+
+	// tokenPath is the endpoint whose response holds an access token.
+	tokenPath = "/api/get_access_token"
+
+	redacted = "REDACTED"
+)
+
+// session describes a recording as a whole.
+type session struct {
+	RecordedAt time.Time `json:"recorded_at"`
+}
+
+// exchange is one recorded request and its response.
+type exchange struct {
+	Method      string          `json:"method"`
+	URL         string          `json:"url"` // path and query, without the host
+	Status      int             `json:"status"`
+	ContentType string          `json:"content_type,omitempty"`
+	Header      http.Header     `json:"header,omitempty"`    // response headers, see recordedHeader
+	Body        json.RawMessage `json:"body,omitempty"`      // the body, if it is JSON
+	BodyText    string          `json:"body_text,omitempty"` // the body, if it is not
+}
+
+// body returns the response body as it was received, apart from redactions.
+func (e exchange) body() []byte {
+	if e.Body != nil {
+		return e.Body
+	}
+	return []byte(e.BodyText)
+}
+
+// recordedHeader returns the response headers worth keeping: all of them but
+// Set-Cookie, and Content-Length, which no longer holds once a body is
+// redacted. A Retry-After given as a date is turned into seconds, so a
+// replayed 429 asks for the same wait it did originally.
+func recordedHeader(h http.Header, now time.Time) http.Header {
+	saved := h.Clone()
+	saved.Del("Set-Cookie")
+	saved.Del("Content-Length")
+	if at, err := http.ParseTime(saved.Get("Retry-After")); err == nil {
+		wait := max(0, int(math.Ceil(at.Sub(now).Seconds())))
+		saved.Set("Retry-After", strconv.Itoa(wait))
+	}
+	if len(saved) == 0 {
+		return nil
+	}
+	return saved
+}
+
+// exchangeFile returns the name of the nth exchange in a session.
+func exchangeFile(n int) string {
+	return fmt.Sprintf("%04d.json", n)
+}
+
+// requestKey identifies a request for replay. The timeFrom parameter is left
+// out because it is derived from the clock, which moves on between recording
+// and replay even when the rest of the search is identical.
+func requestKey(method string, u *url.URL) string {
+	q := u.Query()
+	q.Del("timeFrom")
+	return method + " " + u.Path + "?" + q.Encode()
+}
+
+// redactToken replaces the access token in a token exchange response.
+func redactToken(body []byte) []byte {
+	var resp map[string]json.RawMessage
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return body
+	}
+	if _, ok := resp["token"]; !ok {
+		return body
+	}
+	resp["token"], _ = json.Marshal(redacted)
+	redactedBody, err := json.Marshal(resp)
+	if err != nil {
+		return body
+	}
+	return redactedBody
+}
+
+// writeJSON writes v to path as indented JSON, so recordings are easy to read
+// and diff.
+func writeJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func readJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func sessionPath(dir string) string {
+	return filepath.Join(dir, sessionFile)
+}
@@ -0,0 +1,71 @@
+package recording
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/baz-sh/rtt-cli/internal/rttfake"
+)
+
+func TestReplayKeepsRetryAfter(t *testing.T) {
+	fake := rttfake.New("")
+	fake.RateLimitNext(rttfake.PathLocation, 1, 3*time.Second)
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	dir := t.TempDir()
+	rec, err := NewRecorder(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	url := srv.URL + rttfake.PathLocation + "?code=EUS"
+	resp, err := (&http.Client{Transport: rec}).Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	replay, err := OpenReplay(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = (&http.Client{Transport: replay}).Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("replayed status = %d, want 429", resp.StatusCode)
+	}
+	if got := resp.Header.Get("Retry-After"); got != "3" {
+		t.Errorf("replayed Retry-After = %q, want 3", got)
+	}
+	if resp.ContentLength != int64(len(body)) {
+		t.Errorf("replayed ContentLength = %d, want %d", resp.ContentLength, len(body))
+	}
+}
+
+func TestRecordedHeader(t *testing.T) {
+	now := time.Date(2026, 1, 5, 8, 0, 0, 0, time.UTC)
+	h := http.Header{}
+	h.Set("Content-Type", "application/json")
+	h.Set("Set-Cookie", "session=secret")
+	h.Set("Content-Length", "42")
+	h.Set("Retry-After", now.Add(90*time.Second).Format(http.TimeFormat))
+
+	saved := recordedHeader(h, now)
+	if saved.Get("Set-Cookie") != "" || saved.Get("Content-Length") != "" {
+		t.Errorf("recorded Set-Cookie or Content-Length: %v", saved)
+	}
+	if got := saved.Get("Retry-After"); got != "90" {
+		t.Errorf("Retry-After = %q, want 90", got)
+	}
+	if got := saved.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", got)
+	}
+}
@@ -0,0 +1,124 @@
+package recording
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Replayer is an http.RoundTripper that answers requests from a recorded
+// session instead of the network. Responses to the same request are served in
+// the order they were recorded, after which the last one is repeated, so
+// refreshes during replay see the same changes as the original session.
+type Replayer struct {
+	recordedAt time.Time
+
+	mu        sync.Mutex
+	exchanges map[string][]exchange // by request key, in recorded order
+}
+
+// OpenReplay loads the session recorded in dir.
+func OpenReplay(dir string) (*Replayer, error) {
+	var s session
+	if err := readJSON(sessionPath(dir), &s); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%s does not hold a recording", dir)
+		}
+		return nil, fmt.Errorf("failed to read recording: %w", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recording: %w", err)
+	}
+	var names []string
+	for _, e := range entries {
+		if e.Name() != sessionFile && filepath.Ext(e.Name()) == ".json" {
+			names = append(names, e.Name())
+		}
+	}
+	// Exchange files are numbered, so sort numerically in case a session
+	// outgrew the zero padding.
+	sort.Slice(names, func(i, j int) bool {
+		return exchangeNumber(names[i]) < exchangeNumber(names[j])
+	})
+
+	r := &Replayer{recordedAt: s.RecordedAt, exchanges: make(map[string][]exchange)}
+	for _, name := range names {
+		var ex exchange
+		if err := readJSON(filepath.Join(dir, name), &ex); err != nil {
+			return nil, fmt.Errorf("failed to read recording %s: %w", name, err)
+		}
+		req, err := http.NewRequest(ex.Method, ex.URL, nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid request in recording %s: %w", name, err)
+		}
+		key := requestKey(ex.Method, req.URL)
+		r.exchanges[key] = append(r.exchanges[key], ex)
+	}
+	return r, nil
+}
+
+// Now returns the time the session was recorded, for use as the client's
+// clock so that departed trains, countdowns and relative search times come out
+// as they did originally.
+func (r *Replayer) Now() time.Time {
+	return r.recordedAt
+}
+
+// RoundTrip answers the request from the recording. A request that was never
+// recorded fails with an error.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := requestKey(req.Method, req.URL)
+
+	r.mu.Lock()
+	queue := r.exchanges[key]
+	if len(queue) == 0 {
+		r.mu.Unlock()
+		return nil, fmt.Errorf("no recorded response for %s %s", req.Method, req.URL.RequestURI())
+	}
+	ex := queue[0]
+	if len(queue) > 1 {
+		r.exchanges[key] = queue[1:]
+	}
+	r.mu.Unlock()
+
+	body := ex.body()
+	// Sessions recorded before headers were saved only have the content type
+	header := ex.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	if ex.ContentType != "" && header.Get("Content-Type") == "" {
+		header.Set("Content-Type", ex.ContentType)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", ex.Status, http.StatusText(ex.Status)),
+		StatusCode:    ex.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// exchangeNumber returns the sequence number in an exchange file name.
+func exchangeNumber(name string) int {
+	n, err := strconv.Atoi(name[:len(name)-len(filepath.Ext(name))])
+	if err != nil {
+		return -1
+	}
+	return n
+}
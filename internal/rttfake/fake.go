@@ -0,0 +1,473 @@
+// Package rttfake is a stand-in for the Realtime Trains v2 API. It serves
+// /api/get_access_token, /gb-nr/location and /gb-nr/service from fixture
+// services, and can be scripted to fail in the ways the real API does: rate
+// limits, empty responses, expired tokens, cancelled trains and slow replies.
+//
+// A Fake is an http.Handler, so it can be mounted on httptest.NewServer and
+// passed to api.NewClient with api.WithBaseURL, or served on a real port for
+// working on the app offline (see cmd/rttfake).
+package rttfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Paths served by the fake, for use with FailNext, Delay and Requests.
+const (
+	PathToken    = "/api/get_access_token"
+	PathLocation = "/gb-nr/location"
+	PathService  = "/gb-nr/service"
+)
+
+// DefaultTokenLifetime is how long issued access tokens stay valid.
+const DefaultTokenLifetime = time.Hour
+
+// Fake is a scripted Realtime Trains API. All methods are safe to call while
+// requests are being served.
+type Fake struct {
+	refreshToken string
+
+	mu            sync.Mutex
+	services      map[string]*Service // by unique identity
+	noContent     map[string]bool     // unique identities answered with 204
+	failures      []failure
+	delays        map[string]time.Duration
+	tokens        map[string]time.Time // issued access tokens and their expiry
+	tokenLifetime time.Duration
+	issued        int
+	requests      map[string]int
+}
+
+// failure is a scripted error response for the next few requests to a path.
+type failure struct {
+	path       string
+	status     int
+	retryAfter time.Duration // sent as a Retry-After header if positive
+	remaining  int
+}
+
+// New returns a fake with no services. It hands out access tokens for the
+// given refresh token, or for any refresh token if it is empty.
+func New(refreshToken string) *Fake {
+	return &Fake{
+		refreshToken:  refreshToken,
+		services:      make(map[string]*Service),
+		noContent:     make(map[string]bool),
+		delays:        make(map[string]time.Duration),
+		tokens:        make(map[string]time.Time),
+		tokenLifetime: DefaultTokenLifetime,
+		requests:      make(map[string]int),
+	}
+}
+
+// NewWithFixtures returns a fake loaded with the built-in fixtures.
+func NewWithFixtures(refreshToken string) (*Fake, error) {
+	f := New(refreshToken)
+	if err := f.LoadFixtures(Fixtures); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// LoadFixtures adds every *.json file at the root of fsys, each holding a
+// /gb-nr/service response.
+func (f *Fake) LoadFixtures(fsys fs.FS) error {
+	names, err := fs.Glob(fsys, "*.json")
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		var doc ServiceDocument
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return fmt.Errorf("fixture %s: %w", name, err)
+		}
+		if _, _, err := splitIdentity(doc.Service.ScheduleMetadata.UniqueIdentity); err != nil {
+			return fmt.Errorf("fixture %s: %w", name, err)
+		}
+		f.AddService(doc.Service)
+	}
+	return nil
+}
+
+// AddService adds a service, replacing any with the same unique identity.
+func (f *Fake) AddService(svc Service) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.services[svc.ScheduleMetadata.UniqueIdentity] = &svc
+}
+
+// Services returns the unique identities of all services, in departure order.
+func (f *Fake) Services() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var ids []string
+	for _, svc := range f.sortedServices() {
+		ids = append(ids, svc.ScheduleMetadata.UniqueIdentity)
+	}
+	return ids
+}
+
+// ShiftTo moves every service in time, keeping their spacing, so that the
+// earliest departure is at t. This keeps fixtures recorded on a fixed date
+// useful for searches that start from the current time.
+func (f *Fake) ShiftTo(t time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	sorted := f.sortedServices()
+	if len(sorted) == 0 {
+		return
+	}
+	earliest := firstDeparture(sorted[0])
+	if earliest.IsZero() {
+		return
+	}
+	d := t.Sub(earliest)
+	for _, svc := range f.services {
+		for i := range svc.Locations {
+			svc.Locations[i].TemporalData.Arrival.shift(d)
+			svc.Locations[i].TemporalData.Departure.shift(d)
+		}
+	}
+}
+
+// Cancel marks a service as cancelled at every stop, with an optional reason.
+func (f *Fake) Cancel(uniqueIdentity, reason string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	svc, ok := f.services[uniqueIdentity]
+	if !ok {
+		return fmt.Errorf("unknown service %q", uniqueIdentity)
+	}
+	for i := range svc.Locations {
+		if t := svc.Locations[i].TemporalData.Arrival; t != nil {
+			t.IsCancelled = true
+		}
+		if t := svc.Locations[i].TemporalData.Departure; t != nil {
+			t.IsCancelled = true
+		}
+	}
+	if reason != "" {
+		svc.Reasons = append(svc.Reasons, Reason{Type: "CANCEL", ShortText: reason})
+	}
+	return nil
+}
+
+// NoContent makes the service endpoint answer 204 No Content for a service,
+// as the real API does when it has no details.
+func (f *Fake) NoContent(uniqueIdentity string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.noContent[uniqueIdentity] = true
+}
+
+// FailNext makes the next n requests to path fail with the given status code,
+// for example http.StatusTooManyRequests to simulate rate limiting.
+// Failures scripted for the same path are used up in order.
+func (f *Fake) FailNext(path string, status, n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failures = append(f.failures, failure{path: path, status: status, remaining: n})
+}
+
+// RateLimitNext makes the next n requests to path fail with 429 Too Many
+// Requests, asking the client to wait retryAfter (rounded to whole seconds)
+// before trying again. A zero retryAfter sends no Retry-After header.
+func (f *Fake) RateLimitNext(path string, n int, retryAfter time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failures = append(f.failures, failure{path: path, status: http.StatusTooManyRequests, retryAfter: retryAfter, remaining: n})
+}
+
+// Delay holds every request to path for d before answering. A request whose
+// client gives up first is abandoned, which makes it possible to exercise
+// cancellation and timeouts. A zero duration removes the delay.
+func (f *Fake) Delay(path string, d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.delays[path] = d
+}
+
+// ExpireTokens invalidates every access token issued so far, so the next data
+// request is rejected with 401 and the client has to exchange its refresh
+// token again.
+func (f *Fake) ExpireTokens() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	clear(f.tokens)
+}
+
+// SetTokenLifetime sets how long newly issued access tokens stay valid.
+func (f *Fake) SetTokenLifetime(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.tokenLifetime = d
+}
+
+// Requests returns how many requests have been made to path, including ones
+// that were failed or delayed.
+func (f *Fake) Requests(path string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requests[path]
+}
+
+// TokensIssued returns how many access tokens have been handed out.
+func (f *Fake) TokensIssued() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.issued
+}
+
+func (f *Fake) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	f.mu.Lock()
+	f.requests[r.URL.Path]++
+	delay := f.delays[r.URL.Path]
+	fail, failing := f.nextFailure(r.URL.Path)
+	f.mu.Unlock()
+
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-r.Context().Done():
+			timer.Stop()
+			return
+		}
+	}
+	if failing {
+		if fail.retryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(fail.retryAfter.Round(time.Second).Seconds())))
+		}
+		http.Error(w, http.StatusText(fail.status), fail.status)
+		return
+	}
+
+	switch r.URL.Path {
+	case PathToken:
+		f.serveToken(w, r)
+	case PathLocation:
+		if f.authorize(w, r) {
+			f.serveLocation(w, r)
+		}
+	case PathService:
+		if f.authorize(w, r) {
+			f.serveService(w, r)
+		}
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// nextFailure uses up one scripted failure for path, reporting false if none
+// is left. f.mu must be held.
+func (f *Fake) nextFailure(path string) (failure, bool) {
+	for i := range f.failures {
+		fl := &f.failures[i]
+		if fl.path != path || fl.remaining <= 0 {
+			continue
+		}
+		fl.remaining--
+		return *fl, true
+	}
+	return failure{}, false
+}
+
+func (f *Fake) serveToken(w http.ResponseWriter, r *http.Request) {
+	refresh, ok := bearer(r)
+	if !ok || (f.refreshToken != "" && refresh != f.refreshToken) {
+		http.Error(w, "invalid refresh token", http.StatusUnauthorized)
+		return
+	}
+
+	f.mu.Lock()
+	f.issued++
+	token := fmt.Sprintf("fake-access-%d", f.issued)
+	expiry := time.Now().Add(f.tokenLifetime)
+	f.tokens[token] = expiry
+	f.mu.Unlock()
+
+	writeJSON(w, accessTokenResponse{Token: token, ValidUntil: expiry.Format(time.RFC3339)})
+}
+
+// authorize checks the request carries a live access token, answering 401 if
+// not.
+func (f *Fake) authorize(w http.ResponseWriter, r *http.Request) bool {
+	token, _ := bearer(r)
+	f.mu.Lock()
+	expiry, ok := f.tokens[token]
+	f.mu.Unlock()
+	if !ok || time.Now().After(expiry) {
+		http.Error(w, "access token expired or invalid", http.StatusUnauthorized)
+		return false
+	}
+	return true
+}
+
+func (f *Fake) serveLocation(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	code := strings.ToUpper(q.Get("code"))
+	filterTo := strings.ToUpper(q.Get("filterTo"))
+	if code == "" {
+		http.Error(w, "missing code", http.StatusBadRequest)
+		return
+	}
+
+	timeFrom := time.Now()
+	if s := q.Get("timeFrom"); s != "" {
+		t, err := time.ParseInLocation("2006-01-02T15:04:05", s, time.Local)
+		if err != nil {
+			http.Error(w, "invalid timeFrom", http.StatusBadRequest)
+			return
+		}
+		timeFrom = t
+	}
+	window := time.Hour
+	if s := q.Get("timeWindow"); s != "" {
+		minutes, err := strconv.Atoi(s)
+		if err != nil || minutes < 0 {
+			http.Error(w, "invalid timeWindow", http.StatusBadRequest)
+			return
+		}
+		window = time.Duration(minutes) * time.Minute
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	resp := locationResponse{Services: []locationService{}}
+	for _, svc := range f.sortedServices() {
+		i := callingIndex(svc, code, filterTo)
+		if i < 0 {
+			continue
+		}
+		loc := svc.Locations[i]
+		t := scheduledAt(loc)
+		if t.Before(timeFrom) || t.After(timeFrom.Add(window)) {
+			continue
+		}
+		resp.Services = append(resp.Services, locationService{
+			ScheduleMetadata: svc.ScheduleMetadata,
+			TemporalData:     loc.TemporalData,
+			LocationMetadata: loc.LocationMetadata,
+		})
+	}
+	writeJSON(w, resp)
+}
+
+func (f *Fake) serveService(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	id := "gb-nr:" + q.Get("identity") + ":" + q.Get("departureDate")
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.noContent[id] {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	svc, ok := f.services[id]
+	if !ok {
+		http.Error(w, "service not found", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, ServiceDocument{Service: *svc})
+}
+
+// sortedServices returns the services in order of first departure. f.mu must
+// be held.
+func (f *Fake) sortedServices() []*Service {
+	sorted := make([]*Service, 0, len(f.services))
+	for _, svc := range f.services {
+		sorted = append(sorted, svc)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		ti, tj := firstDeparture(sorted[i]), firstDeparture(sorted[j])
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return sorted[i].ScheduleMetadata.UniqueIdentity < sorted[j].ScheduleMetadata.UniqueIdentity
+	})
+	return sorted
+}
+
+// callingIndex returns the index of the stop at code, or -1 if the service
+// doesn't call there (or, when filterTo is set, doesn't call at filterTo
+// afterwards).
+func callingIndex(svc *Service, code, filterTo string) int {
+	for i, loc := range svc.Locations {
+		if !loc.hasCode(code) || scheduledAt(loc).IsZero() {
+			continue
+		}
+		if filterTo == "" {
+			return i
+		}
+		for _, later := range svc.Locations[i+1:] {
+			if later.hasCode(filterTo) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// scheduledAt returns the booked departure from a stop, or the booked arrival
+// at its final stop. It is zero for stops without advertised times.
+func scheduledAt(loc Location) time.Time {
+	for _, t := range []*Times{loc.TemporalData.Departure, loc.TemporalData.Arrival} {
+		if t == nil {
+			continue
+		}
+		if parsed, err := time.Parse(time.RFC3339, t.ScheduleAdvertised); err == nil {
+			return parsed
+		}
+	}
+	return time.Time{}
+}
+
+func firstDeparture(svc *Service) time.Time {
+	for _, loc := range svc.Locations {
+		if t := scheduledAt(loc); !t.IsZero() {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func splitIdentity(uniqueIdentity string) (identity, date string, err error) {
+	parts := strings.SplitN(uniqueIdentity, ":", 3)
+	if len(parts) != 3 || parts[0] != "gb-nr" {
+		return "", "", fmt.Errorf("invalid service identity %q", uniqueIdentity)
+	}
+	if _, err := time.Parse(time.DateOnly, parts[2]); err != nil {
+		return "", "", fmt.Errorf("invalid service identity %q", uniqueIdentity)
+	}
+	return parts[1], parts[2], nil
+}
+
+func bearer(r *http.Request) (string, bool) {
+	return strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
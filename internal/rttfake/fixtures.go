@@ -0,0 +1,24 @@
+package rttfake
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed fixtures/*.json
+var fixtureFiles embed.FS
+
+// Fixtures holds the built-in services: a Monday morning (5 January 2026) on
+// the West Coast Main Line out of London Euston. They cover an on-time train,
+// a delayed one with a reason, a cancellation, a platform change, a stopping
+// service without realtime data, an empty stock move that isn't in passenger
+// service, and a Crewe–Manchester train for journeys with a change.
+var Fixtures fs.FS
+
+func init() {
+	sub, err := fs.Sub(fixtureFiles, "fixtures")
+	if err != nil {
+		panic(err)
+	}
+	Fixtures = sub
+}
@@ -0,0 +1,107 @@
+package rttfake
+
+import "time"
+
+// The types below mirror the Realtime Trains v2 wire format, so fixture files
+// are plain /gb-nr/service responses.
+
+// ServiceDocument is a /gb-nr/service response body.
+type ServiceDocument struct {
+	Service Service `json:"service"`
+}
+
+// Service is the full schedule of one train.
+type Service struct {
+	ScheduleMetadata ScheduleMetadata `json:"scheduleMetadata"`
+	Locations        []Location       `json:"locations"`
+	Reasons          []Reason         `json:"reasons,omitempty"`
+}
+
+type ScheduleMetadata struct {
+	UniqueIdentity     string   `json:"uniqueIdentity"` // "gb-nr:IDENTITY:YYYY-MM-DD"
+	Operator           Operator `json:"operator"`
+	InPassengerService bool     `json:"inPassengerService"`
+}
+
+type Operator struct {
+	Name string `json:"name"`
+}
+
+// Location is a stop (or timing point) in a service's schedule.
+type Location struct {
+	Location         Place            `json:"location"`
+	TemporalData     TemporalData     `json:"temporalData"`
+	LocationMetadata LocationMetadata `json:"locationMetadata"`
+}
+
+type Place struct {
+	Description string   `json:"description"`
+	ShortCodes  []string `json:"shortCodes"`
+}
+
+type TemporalData struct {
+	Arrival   *Times `json:"arrival,omitempty"`
+	Departure *Times `json:"departure,omitempty"`
+}
+
+// Times are RFC 3339 timestamps; empty strings mean unknown.
+type Times struct {
+	ScheduleAdvertised string `json:"scheduleAdvertised,omitempty"`
+	RealtimeForecast   string `json:"realtimeForecast,omitempty"`
+	RealtimeActual     string `json:"realtimeActual,omitempty"`
+	IsCancelled        bool   `json:"isCancelled,omitempty"`
+}
+
+type LocationMetadata struct {
+	Platform *Platform `json:"platform,omitempty"`
+}
+
+type Platform struct {
+	Planned  string `json:"planned,omitempty"`
+	Forecast string `json:"forecast,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+}
+
+// Reason is a delay or cancellation reason.
+type Reason struct {
+	Type      string `json:"type"` // "CANCEL" or "LATE"
+	ShortText string `json:"shortText,omitempty"`
+	LongText  string `json:"longText,omitempty"`
+}
+
+type locationResponse struct {
+	Services []locationService `json:"services"`
+}
+
+type locationService struct {
+	ScheduleMetadata ScheduleMetadata `json:"scheduleMetadata"`
+	TemporalData     TemporalData     `json:"temporalData"`
+	LocationMetadata LocationMetadata `json:"locationMetadata"`
+}
+
+type accessTokenResponse struct {
+	Token      string `json:"token"`
+	ValidUntil string `json:"validUntil"`
+}
+
+// hasCode reports whether a location has the given CRS code.
+func (l Location) hasCode(code string) bool {
+	for _, sc := range l.Location.ShortCodes {
+		if sc == code {
+			return true
+		}
+	}
+	return false
+}
+
+// shift moves every timestamp by d. Unparseable or empty times are left alone.
+func (t *Times) shift(d time.Duration) {
+	if t == nil {
+		return
+	}
+	for _, s := range []*string{&t.ScheduleAdvertised, &t.RealtimeForecast, &t.RealtimeActual} {
+		if parsed, err := time.Parse(time.RFC3339, *s); err == nil {
+			*s = parsed.Add(d).Format(time.RFC3339)
+		}
+	}
+}
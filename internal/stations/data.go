@@ -0,0 +1,2579 @@
+package stations
+
+// Stations lists every National Rail station. Names and codes are maintained
+// by hand; TIPLOCs, coordinates and countries are filled in from NaPTAN and
+// NPTG by go generate (see gen). Until the generator has been run against
+// those datasets they are only recorded for 59 stations, the London termini
+// and the main interchanges, which were entered by hand.
+var Stations = []Station{
+	{Name: "Abbey Wood", Code: "ABW"},
+	{Name: "Aber", Code: "ABE"},
+	{Name: "Abercynon", Code: "ACY"},
+	{Name: "Aberdare", Code: "ABA"},
+	{Name: "Aberdeen", Code: "ABD", TIPLOC: "ABRDEEN", Lat: 57.1437, Lon: -2.0985, Country: Scotland},
+	{Name: "Aberdour", Code: "AUR"},
+	{Name: "Aberdovey", Code: "AVY"},
+	{Name: "Abererch ", Code: "ABH"},
+	{Name: "Abergavenny", Code: "AGV"},
+	{Name: "Abergele & Pensarn", Code: "AGL"},
+	{Name: "Aberystwyth", Code: "AYW"},
+	{Name: "Accrington", Code: "ACR"},
+	{Name: "Achanalt", Code: "AAT"},
+	{Name: "Achnasheen", Code: "ACN"},
+	{Name: "Achnashellach", Code: "ACH"},
+	{Name: "Acklington", Code: "ACK"},
+	{Name: "Acle", Code: "ACL"},
+	{Name: "Acocks Green", Code: "ACG"},
+	{Name: "Acton Bridge (Cheshire)", Code: "ACB"},
+	{Name: "Acton Central", Code: "ACC"},
+	{Name: "Acton Main Line", Code: "AML"},
+	{Name: "Adderley Park", Code: "ADD"},
+	{Name: "Addiewell", Code: "ADW"},
+	{Name: "Addlestone", Code: "ASN"},
+	{Name: "Adisham", Code: "ADM"},
+	{Name: "Adlington (Cheshire)", Code: "ADC"},
+	{Name: "Adlington (Lancs)", Code: "ADL"},
+	{Name: "Adwick", Code: "AWK"},
+	{Name: "Aigburth", Code: "AIG"},
+	{Name: "Ainsdale", Code: "ANS"},
+	{Name: "Aintree", Code: "AIN"},
+	{Name: "Airbles", Code: "AIR"},
+	{Name: "Airdrie", Code: "ADR"},
+	{Name: "Albany Park", Code: "AYP"},
+	{Name: "Albrighton", Code: "ALB"},
+	{Name: "Alderley Edge", Code: "ALD"},
+	{Name: "Aldermaston", Code: "AMT"},
+	{Name: "Aldershot", Code: "AHT"},
+	{Name: "Aldrington", Code: "AGT"},
+	{Name: "Alexandra Palace", Code: "AAP"},
+	{Name: "Alexandra Parade", Code: "AXP"},
+	{Name: "Alexandria", Code: "ALX"},
+	{Name: "Alfreton", Code: "ALF"},
+	{Name: "Allens West", Code: "ALW"},
+	{Name: "Alloa", Code: "ALO"},
+	{Name: "Alness", Code: "ASS"},
+	{Name: "Alnmouth", Code: "ALM"},
+	{Name: "Alresford (Essex)", Code: "ALR"},
+	{Name: "Alsager", Code: "ASG"},
+	{Name: "Althorne (Essex)", Code: "ALN"},
+	{Name: "Althorpe", Code: "ALP"},
+	{Name: "Altnabreac", Code: "ABC"},
+	{Name: "Alton", Code: "AON"},
+	{Name: "Altrincham", Code: "ALT"},
+	{Name: "Alvechurch", Code: "ALV"},
+	{Name: "Ambergate", Code: "AMB"},
+	{Name: "Amberley", Code: "AMY"},
+	{Name: "Amersham", Code: "AMR"},
+	{Name: "Ammanford", Code: "AMF"},
+	{Name: "Ancaster", Code: "ANC"},
+	{Name: "Anderston", Code: "AND"},
+	{Name: "Andover", Code: "ADV"},
+	{Name: "Anerley", Code: "ANZ"},
+	{Name: "Angmering", Code: "ANG"},
+	{Name: "Annan", Code: "ANN"},
+	{Name: "Anniesland", Code: "ANL"},
+	{Name: "Ansdell & Fairhaven", Code: "AFV"},
+	{Name: "Apperley Bridge", Code: "APY"},
+	{Name: "Appleby", Code: "APP"},
+	{Name: "Appledore (Kent)", Code: "APD"},
+	{Name: "Appleford", Code: "APF"},
+	{Name: "Appley Bridge", Code: "APB"},
+	{Name: "Apsley", Code: "APS"},
+	{Name: "Arbroath", Code: "ARB"},
+	{Name: "Ardgay", Code: "ARD"},
+	{Name: "Ardlui", Code: "AUI"},
+	{Name: "Ardrossan Harbour", Code: "ADS"},
+	{Name: "Ardrossan South Beach", Code: "ASB"},
+	{Name: "Ardrossan Town", Code: "ADN"},
+	{Name: "Ardwick", Code: "ADK"},
+	{Name: "Argyle Street", Code: "AGS"},
+	{Name: "Arisaig", Code: "ARG"},
+	{Name: "Arlesey", Code: "ARL"},
+	{Name: "Armadale (West Lothian)", Code: "ARM"},
+	{Name: "Armathwaite", Code: "AWT"},
+	{Name: "Arnside", Code: "ARN"},
+	{Name: "Arram", Code: "ARR"},
+	{Name: "Arrochar & Tarbet", Code: "ART"},
+	{Name: "Arundel", Code: "ARU"},
+	{Name: "Ascot (Berks)", Code: "ACT"},
+	{Name: "Ascott-under-Wychwood", Code: "AUW"},
+	{Name: "Ash", Code: "ASH"},
+	{Name: "Ash Vale", Code: "AHV"},
+	{Name: "Ashburys", Code: "ABY"},
+	{Name: "Ashchurch for Tewkesbury", Code: "ASC"},
+	{Name: "Ashfield", Code: "ASF"},
+	{Name: "Ashford (Surrey)", Code: "AFS"},
+	{Name: "Ashford International", Code: "AFK"},
+	{Name: "Ashford International (Eurostar)", Code: "ASI"},
+	{Name: "Ashley", Code: "ASY"},
+	{Name: "Ashtead", Code: "AHD"},
+	{Name: "Ashton-under-Lyne", Code: "AHN"},
+	{Name: "Ashurst (Kent)", Code: "AHS"},
+	{Name: "Ashurst New Forest", Code: "ANF"},
+	{Name: "Ashwell & Morden", Code: "AWM"},
+	{Name: "Askam", Code: "ASK"},
+	{Name: "Aslockton", Code: "ALK"},
+	{Name: "Aspatria", Code: "ASP"},
+	{Name: "Aspley Guise", Code: "APG"},
+	{Name: "Aston", Code: "AST"},
+	{Name: "Atherstone", Code: "ATH"},
+	{Name: "Atherton", Code: "ATN"},
+	{Name: "Attadale", Code: "ATT"},
+	{Name: "Attenborough", Code: "ATB"},
+	{Name: "Attleborough", Code: "ATL"},
+	{Name: "Auchinleck", Code: "AUK"},
+	{Name: "Audley End", Code: "AUD"},
+	{Name: "Aughton Park", Code: "AUG"},
+	{Name: "Aviemore", Code: "AVM"},
+	{Name: "Avoncliff", Code: "AVF"},
+	{Name: "Avonmouth", Code: "AVN"},
+	{Name: "Axminster", Code: "AXM"},
+	{Name: "Aylesbury", Code: "AYS"},
+	{Name: "Aylesbury Vale Parkway", Code: "AVP"},
+	{Name: "Aylesford", Code: "AYL"},
+	{Name: "Aylesham", Code: "AYH"},
+	{Name: "Ayr", Code: "AYR"},
+	{Name: "Bache", Code: "BAC"},
+	{Name: "Baglan", Code: "BAJ"},
+	{Name: "Bagshot", Code: "BAG"},
+	{Name: "Baildon", Code: "BLD"},
+	{Name: "Baillieston", Code: "BIO"},
+	{Name: "Balcombe", Code: "BAB"},
+	{Name: "Baldock", Code: "BDK"},
+	{Name: "Balham", Code: "BAL"},
+	{Name: "Balloch", Code: "BHC"},
+	{Name: "Balmossie", Code: "BSI"},
+	{Name: "Bamber Bridge", Code: "BMB"},
+	{Name: "Bamford", Code: "BAM"},
+	{Name: "Banavie", Code: "BNV"},
+	{Name: "Banbury", Code: "BAN"},
+	{Name: "Bangor (Gwynedd)", Code: "BNG"},
+	{Name: "Bank Hall", Code: "BAH"},
+	{Name: "Banstead", Code: "BAD"},
+	{Name: "Barassie", Code: "BSS"},
+	{Name: "Barbican", Code: "ZBB"},
+	{Name: "Bardon Mill", Code: "BLL"},
+	{Name: "Bare Lane", Code: "BAR"},
+	{Name: "Bargeddie", Code: "BGI"},
+	{Name: "Bargoed", Code: "BGD"},
+	{Name: "Barking", Code: "BKG"},
+	{Name: "Barlaston", Code: "BRT"},
+	{Name: "Barming", Code: "BMG"},
+	{Name: "Barmouth", Code: "BRM"},
+	{Name: "Barnehurst", Code: "BNH"},
+	{Name: "Barnes", Code: "BNS"},
+	{Name: "Barnes Bridge", Code: "BNI"},
+	{Name: "Barnetby", Code: "BTB"},
+	{Name: "Barnham", Code: "BAA"},
+	{Name: "Barnhill", Code: "BNL"},
+	{Name: "Barnsley", Code: "BNY"},
+	{Name: "Barnstaple", Code: "BNP"},
+	{Name: "Barnt Green", Code: "BTG"},
+	{Name: "Barrhead", Code: "BRR"},
+	{Name: "Barrhill", Code: "BRL"},
+	{Name: "Barrow Haven", Code: "BAV"},
+	{Name: "Barrow-in-Furness", Code: "BIF"},
+	{Name: "Barrow-Upon-Soar", Code: "BWS"},
+	{Name: "Barry", Code: "BRY"},
+	{Name: "Barry Docks", Code: "BYD"},
+	{Name: "Barry Island", Code: "BYI"},
+	{Name: "Barry Links", Code: "BYL"},
+	{Name: "Barton-on-Humber", Code: "BAU"},
+	{Name: "Basildon", Code: "BSO"},
+	{Name: "Basingstoke", Code: "BSK"},
+	{Name: "Bat & Ball", Code: "BBL"},
+	{Name: "Bath Spa", Code: "BTH", TIPLOC: "BATHSPA", Lat: 51.3776, Lon: -2.3571, Country: England},
+	{Name: "Bathgate", Code: "BHG"},
+	{Name: "Batley", Code: "BTL"},
+	{Name: "Battersby", Code: "BTT"},
+	{Name: "Battersea Park", Code: "BAK"},
+	{Name: "Battle", Code: "BAT"},
+	{Name: "Battlesbridge", Code: "BLB"},
+	{Name: "Bayford", Code: "BAY"},
+	{Name: "Beaconsfield", Code: "BCF"},
+	{Name: "Bearley", Code: "BER"},
+	{Name: "Bearsden", Code: "BRN"},
+	{Name: "Bearsted", Code: "BSD"},
+	{Name: "Beasdale", Code: "BSL"},
+	{Name: "Beaulieu Road", Code: "BEU"},
+	{Name: "Beauly", Code: "BEL"},
+	{Name: "Bebington", Code: "BEB"},
+	{Name: "Beccles", Code: "BCC"},
+	{Name: "Beckenham Hill", Code: "BEC"},
+	{Name: "Beckenham Junction", Code: "BKJ"},
+	{Name: "Bedford", Code: "BDM"},
+	{Name: "Bedford St Johns", Code: "BSJ"},
+	{Name: "Bedhampton", Code: "BDH"},
+	{Name: "Bedminster", Code: "BMT"},
+	{Name: "Bedworth", Code: "BEH"},
+	{Name: "Bedwyn", Code: "BDW"},
+	{Name: "Beeston", Code: "BEE"},
+	{Name: "Bekesbourne", Code: "BKS"},
+	{Name: "Belle Vue", Code: "BLV"},
+	{Name: "Bellgrove", Code: "BLG"},
+	{Name: "Bellingham", Code: "BGM"},
+	{Name: "Bellshill", Code: "BLH"},
+	{Name: "Belmont", Code: "BLM"},
+	{Name: "Belper", Code: "BLP"},
+	{Name: "Beltring", Code: "BEG"},
+	{Name: "Belvedere", Code: "BVD"},
+	{Name: "Bempton", Code: "BEM"},
+	{Name: "Ben Rhydding", Code: "BEY"},
+	{Name: "Benfleet", Code: "BEF"},
+	{Name: "Bentham", Code: "BEN"},
+	{Name: "Bentley (Hants)", Code: "BTY"},
+	{Name: "Bentley (South Yorks)", Code: "BYK"},
+	{Name: "Bere Alston", Code: "BAS"},
+	{Name: "Bere Ferrers", Code: "BFE"},
+	{Name: "Berkhamsted", Code: "BKM"},
+	{Name: "Berkswell", Code: "BKW"},
+	{Name: "Bermuda Park", Code: "BEP"},
+	{Name: "Berney Arms", Code: "BYA"},
+	{Name: "Berry Brow", Code: "BBW"},
+	{Name: "Berrylands", Code: "BRS"},
+	{Name: "Berwick (Sussex)", Code: "BRK"},
+	{Name: "Berwick-upon-Tweed", Code: "BWK"},
+	{Name: "Bescar Lane", Code: "BES"},
+	{Name: "Bescot Stadium", Code: "BSC"},
+	{Name: "Betchworth", Code: "BTO"},
+	{Name: "Bethnal Green", Code: "BET"},
+	{Name: "Betws-y-Coed", Code: "BYC"},
+	{Name: "Beverley", Code: "BEV"},
+	{Name: "Bexhill", Code: "BEX"},
+	{Name: "Bexley", Code: "BXY"},
+	{Name: "Bexleyheath", Code: "BXH"},
+	{Name: "Bicester North", Code: "BCS"},
+	{Name: "Bicester Village", Code: "BIT"},
+	{Name: "Bickley", Code: "BKL"},
+	{Name: "Bidston", Code: "BID"},
+	{Name: "Biggleswade", Code: "BIW"},
+	{Name: "Bilbrook", Code: "BBK"},
+	{Name: "Billericay", Code: "BIC"},
+	{Name: "Billingham (Cleveland)", Code: "BIL"},
+	{Name: "Billingshurst", Code: "BIG"},
+	{Name: "Bingham", Code: "BIN"},
+	{Name: "Bingley", Code: "BIY"},
+	{Name: "Birchgrove", Code: "BCG"},
+	{Name: "Birchington-on-sea", Code: "BCH"},
+	{Name: "Birchwood", Code: "BWD"},
+	{Name: "Birkbeck", Code: "BIK"},
+	{Name: "Birkdale", Code: "BDL"},
+	{Name: "Birkenhead Central", Code: "BKC"},
+	{Name: "Birkenhead Hamilton Square", Code: "BKQ"},
+	{Name: "Birkenhead North", Code: "BKN"},
+	{Name: "Birkenhead Park", Code: "BKP"},
+	{Name: "Birmingham International", Code: "BHI", TIPLOC: "BHAMINT", Lat: 52.4508, Lon: -1.7253, Country: England},
+	{Name: "Birmingham Moor Street", Code: "BMO"},
+	{Name: "Birmingham New Street", Code: "BHM", TIPLOC: "BHAMNWS", Lat: 52.4778, Lon: -1.8990, Country: England},
+	{Name: "Birmingham Snow Hill", Code: "BSW"},
+	{Name: "Bishop Auckland", Code: "BIA"},
+	{Name: "Bishopbriggs", Code: "BBG"},
+	{Name: "Bishops Stortford", Code: "BIS"},
+	{Name: "Bishopstone (Sussex)", Code: "BIP"},
+	{Name: "Bishopton (Strathclyde)", Code: "BPT"},
+	{Name: "Bitterne", Code: "BTE"},
+	{Name: "Blackburn", Code: "BBN"},
+	{Name: "Blackheath", Code: "BKH"},
+	{Name: "Blackhorse Road", Code: "BHO"},
+	{Name: "Blackpool North", Code: "BPN"},
+	{Name: "Blackpool Pleasure Beach", Code: "BPB"},
+	{Name: "Blackpool South", Code: "BPS"},
+	{Name: "Blackridge", Code: "BKR"},
+	{Name: "Blackrod", Code: "BLK"},
+	{Name: "Blackwater", Code: "BAW"},
+	{Name: "Blaenau Ffestiniog", Code: "BFF"},
+	{Name: "Blair Atholl", Code: "BLA"},
+	{Name: "Blairhill", Code: "BAI"},
+	{Name: "Blake Street", Code: "BKT"},
+	{Name: "Blakedown", Code: "BKD"},
+	{Name: "Blantyre", Code: "BLT"},
+	{Name: "Blaydon", Code: "BLO"},
+	{Name: "Bleasby", Code: "BSB"},
+	{Name: "Bletchley", Code: "BLY"},
+	{Name: "Bloxwich", Code: "BLX"},
+	{Name: "Bloxwich North", Code: "BWN"},
+	{Name: "Blundellsands & Crosby", Code: "BLN"},
+	{Name: "Blythe Bridge", Code: "BYB"},
+	{Name: "Bodmin Parkway", Code: "BOD"},
+	{Name: "Bodorgan", Code: "BOR"},
+	{Name: "Bognor Regis", Code: "BOG"},
+	{Name: "Bogston", Code: "BGS"},
+	{Name: "Bolton", Code: "BON"},
+	{Name: "Bolton-Upon-Dearne", Code: "BTD"},
+	{Name: "Bookham", Code: "BKA"},
+	{Name: "Bootle (Cumbria)", Code: "BOC"},
+	{Name: "Bootle New Strand", Code: "BNW"},
+	{Name: "Bootle Oriel Road", Code: "BOT"},
+	{Name: "Bordesley", Code: "BBS"},
+	{Name: "Borough Green & Wrotham", Code: "BRG"},
+	{Name: "Borth", Code: "BRH"},
+	{Name: "Bosham", Code: "BOH"},
+	{Name: "Boston", Code: "BSN"},
+	{Name: "Botley", Code: "BOE"},
+	{Name: "Bottesford", Code: "BTF"},
+	{Name: "Bourne End", Code: "BNE"},
+	{Name: "Bournemouth", Code: "BMH"},
+	{Name: "Bournville", Code: "BRV"},
+	{Name: "Bow Brickhill", Code: "BWB"},
+	{Name: "Bowes Park", Code: "BOP"},
+	{Name: "Bowling", Code: "BWG"},
+	{Name: "Box Hill & Westhumble", Code: "BXW"},
+	{Name: "Bracknell", Code: "BCE"},
+	{Name: "Bradford Forster Square", Code: "BDQ"},
+	{Name: "Bradford Interchange", Code: "BDI"},
+	{Name: "Bradford-on-Avon", Code: "BOA"},
+	{Name: "Brading", Code: "BDN"},
+	{Name: "Braintree", Code: "BTR"},
+	{Name: "Braintree Freeport", Code: "BTP"},
+	{Name: "Bramhall", Code: "BML"},
+	{Name: "Bramley (Hants)", Code: "BMY"},
+	{Name: "Bramley (W Yorks)", Code: "BLE"},
+	{Name: "Brampton (Cumbria)", Code: "BMP"},
+	{Name: "Brampton (Suffolk)", Code: "BRP"},
+	{Name: "Branchton", Code: "BCN"},
+	{Name: "Brandon", Code: "BND"},
+	{Name: "Branksome", Code: "BSM"},
+	{Name: "Braystones (Cumbria)", Code: "BYS"},
+	{Name: "Bredbury", Code: "BDY"},
+	{Name: "Breich", Code: "BRC"},
+	{Name: "Brentford", Code: "BFD"},
+	{Name: "Brentwood", Code: "BRE"},
+	{Name: "Bricket Wood", Code: "BWO"},
+	{Name: "Bridge of Allan", Code: "BEA"},
+	{Name: "Bridge of Orchy", Code: "BRO"},
+	{Name: "Bridgend", Code: "BGN"},
+	{Name: "Bridgeton", Code: "BDG"},
+	{Name: "Bridgwater", Code: "BWT"},
+	{Name: "Bridlington", Code: "BDT"},
+	{Name: "Brierfield", Code: "BRF"},
+	{Name: "Brigg", Code: "BGG"},
+	{Name: "Brighouse", Code: "BGH"},
+	{Name: "Brighton (East Sussex)", Code: "BTN", TIPLOC: "BRGHTN", Lat: 50.8290, Lon: -0.1412, Country: England},
+	{Name: "Brimsdown", Code: "BMD"},
+	{Name: "Brinnington", Code: "BNT"},
+	{Name: "Bristol Parkway", Code: "BPW"},
+	{Name: "Bristol Temple Meads", Code: "BRI", TIPLOC: "BRSTLTM", Lat: 51.4491, Lon: -2.5813, Country: England},
+	{Name: "Brithdir", Code: "BHD"},
+	{Name: "Briton Ferry", Code: "BNF"},
+	{Name: "Brixton", Code: "BRX"},
+	{Name: "Broad Green", Code: "BGE"},
+	{Name: "Broadbottom", Code: "BDB"},
+	{Name: "Broadstairs", Code: "BSR"},
+	{Name: "Brockenhurst", Code: "BCU"},
+	{Name: "Brockholes", Code: "BHS"},
+	{Name: "Brockley", Code: "BCY"},
+	{Name: "Bromborough", Code: "BOM"},
+	{Name: "Bromborough Rake", Code: "BMR"},
+	{Name: "Bromley Cross (Lancs)", Code: "BMC"},
+	{Name: "Bromley North", Code: "BMN"},
+	{Name: "Bromley South", Code: "BMS"},
+	{Name: "Bromsgrove", Code: "BMV"},
+	{Name: "Brondesbury", Code: "BSY"},
+	{Name: "Brondesbury Park", Code: "BSP"},
+	{Name: "Brookmans Park", Code: "BPK"},
+	{Name: "Brookwood", Code: "BKO"},
+	{Name: "Broome", Code: "BME"},
+	{Name: "Broomfleet", Code: "BMF"},
+	{Name: "Brora", Code: "BRA"},
+	{Name: "Brough", Code: "BUH"},
+	{Name: "Broughty Ferry", Code: "BYF"},
+	{Name: "Broxbourne", Code: "BXB"},
+	{Name: "Bruce Grove", Code: "BCV"},
+	{Name: "Brundall", Code: "BDA"},
+	{Name: "Brundall Gardens", Code: "BGA"},
+	{Name: "Brunstane", Code: "BSU"},
+	{Name: "Brunswick", Code: "BRW"},
+	{Name: "Bruton", Code: "BRU"},
+	{Name: "Bryn", Code: "BYN"},
+	{Name: "Buckenham (Norfolk)", Code: "BUC"},
+	{Name: "Buckley", Code: "BCK"},
+	{Name: "Bucknell", Code: "BUK"},
+	{Name: "Buckshaw Parkway", Code: "BSV"},
+	{Name: "Bugle", Code: "BGL"},
+	{Name: "Builth Road", Code: "BHR"},
+	{Name: "Bulwell", Code: "BLW"},
+	{Name: "Bures", Code: "BUE"},
+	{Name: "Burgess Hill", Code: "BUG"},
+	{Name: "Burley Park", Code: "BUY"},
+	{Name: "Burley-in-Wharfedale", Code: "BUW"},
+	{Name: "Burnage", Code: "BNA"},
+	{Name: "Burneside (Cumbria)", Code: "BUD"},
+	{Name: "Burnham (Bucks)", Code: "BNM"},
+	{Name: "Burnham-on-Crouch", Code: "BUU"},
+	{Name: "Burnley Barracks", Code: "BUB"},
+	{Name: "Burnley Central", Code: "BNC"},
+	{Name: "Burnley Manchester Road", Code: "BYM"},
+	{Name: "Burnside (Strathclyde)", Code: "BUI"},
+	{Name: "Burntisland", Code: "BTS"},
+	{Name: "Burscough Bridge", Code: "BCB"},
+	{Name: "Burscough Junction", Code: "BCJ"},
+	{Name: "Bursledon", Code: "BUO"},
+	{Name: "Burton Joyce", Code: "BUJ"},
+	{Name: "Burton-on-Trent", Code: "BUT"},
+	{Name: "Bury St Edmunds", Code: "BSE"},
+	{Name: "Busby", Code: "BUS"},
+	{Name: "Bush Hill Park", Code: "BHK"},
+	{Name: "Bushey", Code: "BSH"},
+	{Name: "Butlers Lane", Code: "BUL"},
+	{Name: "Buxted", Code: "BXD"},
+	{Name: "Buxton", Code: "BUX"},
+	{Name: "Byfleet & New Haw", Code: "BFN"},
+	{Name: "Bynea", Code: "BYE"},
+	{Name: "Cadoxton", Code: "CAD"},
+	{Name: "Caergwrle", Code: "CGW"},
+	{Name: "Caerphilly", Code: "CPH"},
+	{Name: "Caersws", Code: "CWS"},
+	{Name: "Caldercruix", Code: "CAC"},
+	{Name: "Caldicot", Code: "CDT"},
+	{Name: "Caledonian Rd & Barnsbury", Code: "CIR"},
+	{Name: "Calstock", Code: "CSK"},
+	{Name: "Cam & Dursley", Code: "CDU"},
+	{Name: "Camberley", Code: "CAM"},
+	{Name: "Camborne", Code: "CBN"},
+	{Name: "Cambridge", Code: "CBG", TIPLOC: "CAMBDGE", Lat: 52.1940, Lon: 0.1375, Country: England},
+	{Name: "Cambridge Heath", Code: "CBH"},
+	{Name: "Cambuslang", Code: "CBL"},
+	{Name: "Camden Road", Code: "CMD"},
+	{Name: "Camelon", Code: "CMO"},
+	{Name: "Canada Water", Code: "ZCW"},
+	{Name: "Canley", Code: "CNL"},
+	{Name: "Cannock", Code: "CAO"},
+	{Name: "Canonbury", Code: "CNN"},
+	{Name: "Canterbury East", Code: "CBE"},
+	{Name: "Canterbury West", Code: "CBW"},
+	{Name: "Cantley", Code: "CNY"},
+	{Name: "Capenhurst", Code: "CPU"},
+	{Name: "Carbis Bay", Code: "CBB"},
+	{Name: "Cardenden", Code: "CDD"},
+	{Name: "Cardiff Bay", Code: "CDB"},
+	{Name: "Cardiff Central", Code: "CDF", TIPLOC: "CRDFCEN", Lat: 51.4752, Lon: -3.1791, Country: Wales},
+	{Name: "Cardiff Queen Street", Code: "CDQ"},
+	{Name: "Cardonald", Code: "CDO"},
+	{Name: "Cardross", Code: "CDR"},
+	{Name: "Carfin", Code: "CRF"},
+	{Name: "Cark & Cartmel", Code: "CAK"},
+	{Name: "Carlisle", Code: "CAR", TIPLOC: "CARLILE", Lat: 54.8907, Lon: -2.9335, Country: England},
+	{Name: "Carlton", Code: "CTO"},
+	{Name: "Carluke", Code: "CLU"},
+	{Name: "Carmarthen", Code: "CMN"},
+	{Name: "Carmyle", Code: "CML"},
+	{Name: "Carnforth", Code: "CNF"},
+	{Name: "Carnoustie", Code: "CAN"},
+	{Name: "Carntyne", Code: "CAY"},
+	{Name: "Carpenders Park", Code: "CPK"},
+	{Name: "Carrbridge", Code: "CAG"},
+	{Name: "Carshalton", Code: "CSH"},
+	{Name: "Carshalton Beeches", Code: "CSB"},
+	{Name: "Carstairs", Code: "CRS"},
+	{Name: "Cartsdyke", Code: "CDY"},
+	{Name: "Castle Bar Park", Code: "CBP"},
+	{Name: "Castle Cary", Code: "CLC"},
+	{Name: "Castleford", Code: "CFD"},
+	{Name: "Castleton (Manchester)", Code: "CAS"},
+	{Name: "Castleton Moor", Code: "CSM"},
+	{Name: "Caterham", Code: "CAT"},
+	{Name: "Catford", Code: "CTF"},
+	{Name: "Catford Bridge", Code: "CFB"},
+	{Name: "Cathays", Code: "CYS"},
+	{Name: "Cathcart", Code: "CCT"},
+	{Name: "Cattal", Code: "CTL"},
+	{Name: "Causeland", Code: "CAU"},
+	{Name: "Cefn-y-Bedd", Code: "CYB"},
+	{Name: "Chadwell Heath", Code: "CTH"},
+	{Name: "Chafford Hundred Lakeside", Code: "CFH"},
+	{Name: "Chalfont & Latimer", Code: "CFO"},
+	{Name: "Chalkwell", Code: "CHW"},
+	{Name: "Chandlers Ford", Code: "CFR"},
+	{Name: "Chapel-en-le-Frith", Code: "CEF"},
+	{Name: "Chapelton (Devon)", Code: "CPN"},
+	{Name: "Chapeltown (South Yorks)", Code: "CLN"},
+	{Name: "Chappel & Wakes Colne", Code: "CWC"},
+	{Name: "Charing (Kent)", Code: "CHG"},
+	{Name: "Charing Cross (Glasgow)", Code: "CHC"},
+	{Name: "Charlbury", Code: "CBY"},
+	{Name: "Charlton", Code: "CTN"},
+	{Name: "Chartham", Code: "CRT"},
+	{Name: "Chassen Road", Code: "CSR"},
+	{Name: "Chatelherault", Code: "CTE"},
+	{Name: "Chatham", Code: "CTM"},
+	{Name: "Chathill", Code: "CHT"},
+	{Name: "Cheadle Hulme", Code: "CHU"},
+	{Name: "Cheam", Code: "CHE"},
+	{Name: "Cheddington", Code: "CED"},
+	{Name: "Chelford (Cheshire)", Code: "CEL"},
+	{Name: "Chelmsford", Code: "CHM"},
+	{Name: "Chelsfield", Code: "CLD"},
+	{Name: "Cheltenham Spa", Code: "CNM"},
+	{Name: "Chepstow", Code: "CPW"},
+	{Name: "Cherry Tree", Code: "CYT"},
+	{Name: "Chertsey", Code: "CHY"},
+	{Name: "Cheshunt", Code: "CHN"},
+	{Name: "Chessington North", Code: "CSN"},
+	{Name: "Chessington South", Code: "CSS"},
+	{Name: "Chester", Code: "CTR", TIPLOC: "CHST", Lat: 53.1968, Lon: -2.8797, Country: England},
+	{Name: "Chester Road", Code: "CRD"},
+	{Name: "Chesterfield", Code: "CHD"},
+	{Name: "Chester-le-Street", Code: "CLS"},
+	{Name: "Chestfield & Swalecliffe", Code: "CSW"},
+	{Name: "Chetnole", Code: "CNO"},
+	{Name: "Chichester", Code: "CCH"},
+	{Name: "Chilham", Code: "CIL"},
+	{Name: "Chilworth", Code: "CHL"},
+	{Name: "Chingford", Code: "CHI"},
+	{Name: "Chinley", Code: "CLY"},
+	{Name: "Chippenham", Code: "CPM"},
+	{Name: "Chipstead", Code: "CHP"},
+	{Name: "Chirk", Code: "CRK"},
+	{Name: "Chislehurst", Code: "CIT"},
+	{Name: "Chiswick", Code: "CHK"},
+	{Name: "Cholsey", Code: "CHO"},
+	{Name: "Chorley", Code: "CRL"},
+	{Name: "Chorleywood", Code: "CLW"},
+	{Name: "Christchurch", Code: "CHR"},
+	{Name: "Christs Hospital", Code: "CHH"},
+	{Name: "Church & Oswaldtwistle", Code: "CTW"},
+	{Name: "Church Fenton", Code: "CHF"},
+	{Name: "Church Stretton", Code: "CTT"},
+	{Name: "Cilmeri", Code: "CIM"},
+	{Name: "City Thameslink", Code: "CTK"},
+	{Name: "Clacton-on-Sea", Code: "CLT"},
+	{Name: "Clandon", Code: "CLA"},
+	{Name: "Clapham (North Yorkshire)", Code: "CPY"},
+	{Name: "Clapham High Street", Code: "CLP"},
+	{Name: "Clapham Junction", Code: "CLJ"},
+	{Name: "Clapton", Code: "CPT"},
+	{Name: "Clarbeston Road", Code: "CLR"},
+	{Name: "Clarkston", Code: "CKS"},
+	{Name: "Claverdon", Code: "CLV"},
+	{Name: "Claygate", Code: "CLG"},
+	{Name: "Cleethorpes", Code: "CLE"},
+	{Name: "Cleland", Code: "CEA"},
+	{Name: "Clifton (Manchester)", Code: "CLI"},
+	{Name: "Clifton Down", Code: "CFN"},
+	{Name: "Clitheroe", Code: "CLH"},
+	{Name: "Clock House", Code: "CLK"},
+	{Name: "Clunderwen", Code: "CUW"},
+	{Name: "Clydebank", Code: "CYK"},
+	{Name: "Coatbridge Central", Code: "CBC"},
+	{Name: "Coatbridge Sunnyside", Code: "CBS"},
+	{Name: "Coatdyke", Code: "COA"},
+	{Name: "Cobham & Stoke d'Abernon", Code: "CSD"},
+	{Name: "Codsall", Code: "CSL"},
+	{Name: "Cogan", Code: "CGN"},
+	{Name: "Colchester", Code: "COL"},
+	{Name: "Colchester Town", Code: "CET"},
+	{Name: "Coleshill Parkway", Code: "CEH"},
+	{Name: "Collingham", Code: "CLM"},
+	{Name: "Collington", Code: "CLL"},
+	{Name: "Colne", Code: "CNE"},
+	{Name: "Colwall", Code: "CWL"},
+	{Name: "Colwyn Bay", Code: "CWB"},
+	{Name: "Combe (Oxon)", Code: "CME"},
+	{Name: "Commondale", Code: "COM"},
+	{Name: "Congleton", Code: "CNG"},
+	{Name: "Conisbrough", Code: "CNS"},
+	{Name: "Connel Ferry", Code: "CON"},
+	{Name: "Conon Bridge", Code: "CBD"},
+	{Name: "Cononley", Code: "CEY"},
+	{Name: "Conway Park", Code: "CNP"},
+	{Name: "Conwy", Code: "CNW"},
+	{Name: "Cooden Beach", Code: "COB"},
+	{Name: "Cookham", Code: "COO"},
+	{Name: "Cooksbridge", Code: "CBR"},
+	{Name: "Coombe Junction Halt", Code: "COE"},
+	{Name: "Copplestone", Code: "COP"},
+	{Name: "Corbridge", Code: "CRB"},
+	{Name: "Corby", Code: "COR"},
+	{Name: "Corkerhill", Code: "CKH"},
+	{Name: "Corkickle", Code: "CKL"},
+	{Name: "Corpach", Code: "CPA"},
+	{Name: "Corrour", Code: "CRR"},
+	{Name: "Coryton", Code: "COY"},
+	{Name: "Coseley", Code: "CSY"},
+	{Name: "Cosford", Code: "COS"},
+	{Name: "Cosham", Code: "CSA"},
+	{Name: "Cottingham", Code: "CGM"},
+	{Name: "Cottingley", Code: "COT"},
+	{Name: "Coulsdon South", Code: "CDS"},
+	{Name: "Coulsdon Town", Code: "CDN"},
+	{Name: "Coventry", Code: "COV", TIPLOC: "COVNTRY", Lat: 52.4008, Lon: -1.5135, Country: England},
+	{Name: "Coventry Arena", Code: "CAA"},
+	{Name: "Cowden (Kent)", Code: "CWN"},
+	{Name: "Cowdenbeath", Code: "COW"},
+	{Name: "Cradley Heath", Code: "CRA"},
+	{Name: "Craigendoran", Code: "CGD"},
+	{Name: "Cramlington", Code: "CRM"},
+	{Name: "Cranbrook (Devon)", Code: "CBK"},
+	{Name: "Craven Arms", Code: "CRV"},
+	{Name: "Crawley", Code: "CRW"},
+	{Name: "Crayford", Code: "CRY"},
+	{Name: "Crediton", Code: "CDI"},
+	{Name: "Cressing (Essex)", Code: "CES"},
+	{Name: "Cressington", Code: "CSG"},
+	{Name: "Creswell", Code: "CWD"},
+	{Name: "Crewe", Code: "CRE", TIPLOC: "CREWE", Lat: 53.0896, Lon: -2.4330, Country: England},
+	{Name: "Crewkerne", Code: "CKN"},
+	{Name: "Crews Hill", Code: "CWH"},
+	{Name: "Crianlarich", Code: "CNR"},
+	{Name: "Criccieth", Code: "CCC"},
+	{Name: "Cricklewood", Code: "CRI"},
+	{Name: "Croftfoot", Code: "CFF"},
+	{Name: "Crofton Park", Code: "CFT"},
+	{Name: "Cromer", Code: "CMR"},
+	{Name: "Cromford", Code: "CMF"},
+	{Name: "Crookston", Code: "CKT"},
+	{Name: "Cross Gates", Code: "CRG"},
+	{Name: "Crossflatts", Code: "CFL"},
+	{Name: "Crosshill", Code: "COI"},
+	{Name: "Crosskeys", Code: "CKY"},
+	{Name: "Crossmyloof", Code: "CMY"},
+	{Name: "Croston", Code: "CSO"},
+	{Name: "Crouch Hill", Code: "CRH"},
+	{Name: "Crowborough", Code: "COH"},
+	{Name: "Crowhurst", Code: "CWU"},
+	{Name: "Crowle", Code: "CWE"},
+	{Name: "Crowthorne", Code: "CRN"},
+	{Name: "Croy", Code: "CRO"},
+	{Name: "Crystal Palace", Code: "CYP"},
+	{Name: "Cuddington", Code: "CUD"},
+	{Name: "Cuffley", Code: "CUF"},
+	{Name: "Culham", Code: "CUM"},
+	{Name: "Culrain", Code: "CUA"},
+	{Name: "Cumbernauld", Code: "CUB"},
+	{Name: "Cupar", Code: "CUP"},
+	{Name: "Curriehill", Code: "CUH"},
+	{Name: "Cuxton", Code: "CUX"},
+	{Name: "Cwmbach", Code: "CMH"},
+	{Name: "Cwmbran", Code: "CWM"},
+	{Name: "Cynghordy", Code: "CYN"},
+	{Name: "Dagenham Dock", Code: "DDK"},
+	{Name: "Daisy Hill", Code: "DSY"},
+	{Name: "Dalgety Bay", Code: "DAG"},
+	{Name: "Dalmally", Code: "DAL"},
+	{Name: "Dalmarnock", Code: "DAK"},
+	{Name: "Dalmeny", Code: "DAM"},
+	{Name: "Dalmuir", Code: "DMR"},
+	{Name: "Dalreoch", Code: "DLR"},
+	{Name: "Dalry", Code: "DLY"},
+	{Name: "Dalston (Cumbria)", Code: "DLS"},
+	{Name: "Dalston Junction", Code: "DLJ"},
+	{Name: "Dalston Kingsland", Code: "DLK"},
+	{Name: "Dalton (Cumbria)", Code: "DLT"},
+	{Name: "Dalwhinnie", Code: "DLW"},
+	{Name: "Danby", Code: "DNY"},
+	{Name: "Danescourt", Code: "DCT"},
+	{Name: "Danzey", Code: "DZY"},
+	{Name: "Darlington", Code: "DAR"},
+	{Name: "Darnall", Code: "DAN"},
+	{Name: "Darsham", Code: "DSM"},
+	{Name: "Dartford", Code: "DFD"},
+	{Name: "Darton", Code: "DRT"},
+	{Name: "Darwen", Code: "DWN"},
+	{Name: "Datchet", Code: "DAT"},
+	{Name: "Davenport", Code: "DVN"},
+	{Name: "Dawlish", Code: "DWL"},
+	{Name: "Dawlish Warren", Code: "DWW"},
+	{Name: "Deal", Code: "DEA"},
+	{Name: "Dean (Wilts)", Code: "DEN"},
+	{Name: "Deansgate", Code: "DGT"},
+	{Name: "Deganwy", Code: "DGY"},
+	{Name: "Deighton", Code: "DHN"},
+	{Name: "Delamere", Code: "DLM"},
+	{Name: "Denby Dale", Code: "DBD"},
+	{Name: "Denham", Code: "DNM"},
+	{Name: "Denham Golf Club", Code: "DGC"},
+	{Name: "Denmark Hill", Code: "DMK"},
+	{Name: "Dent", Code: "DNT"},
+	{Name: "Denton", Code: "DTN"},
+	{Name: "Deptford", Code: "DEP"},
+	{Name: "Derby", Code: "DBY", TIPLOC: "DRBY", Lat: 52.9165, Lon: -1.4634, Country: England},
+	{Name: "Derby Road (Ipswich)", Code: "DBR"},
+	{Name: "Devonport (Devon)", Code: "DPT"},
+	{Name: "Devonport Dockyard", Code: "DOC"},
+	{Name: "Dewsbury", Code: "DEW"},
+	{Name: "Didcot Parkway", Code: "DID"},
+	{Name: "Digby & Sowton", Code: "DIG"},
+	{Name: "Dilton Marsh", Code: "DMH"},
+	{Name: "Dinas (Rhondda)", Code: "DMG"},
+	{Name: "Dinas Powys", Code: "DNS"},
+	{Name: "Dingle Road", Code: "DGL"},
+	{Name: "Dingwall", Code: "DIN"},
+	{Name: "Dinsdale", Code: "DND"},
+	{Name: "Dinting", Code: "DTG"},
+	{Name: "Disley", Code: "DSL"},
+	{Name: "Diss", Code: "DIS"},
+	{Name: "Dodworth", Code: "DOD"},
+	{Name: "Dolau", Code: "DOL"},
+	{Name: "Doleham", Code: "DLH"},
+	{Name: "Dolgarrog", Code: "DLG"},
+	{Name: "Dolwyddelan", Code: "DWD"},
+	{Name: "Doncaster", Code: "DON", TIPLOC: "DONC", Lat: 53.5222, Lon: -1.1398, Country: England},
+	{Name: "Dorchester South", Code: "DCH"},
+	{Name: "Dorchester West", Code: "DCW"},
+	{Name: "Dore & Totley", Code: "DOR"},
+	{Name: "Dorking (Main)", Code: "DKG"},
+	{Name: "Dorking Deepdene", Code: "DPD"},
+	{Name: "Dorking West", Code: "DKT"},
+	{Name: "Dormans", Code: "DMS"},
+	{Name: "Dorridge", Code: "DDG"},
+	{Name: "Dove Holes", Code: "DVH"},
+	{Name: "Dover Priory", Code: "DVP"},
+	{Name: "Dovercourt", Code: "DVC"},
+	{Name: "Dovey Junction", Code: "DVY"},
+	{Name: "Downham Market", Code: "DOW"},
+	{Name: "Drayton Green", Code: "DRG"},
+	{Name: "Drayton Park", Code: "DYP"},
+	{Name: "Drem", Code: "DRM"},
+	{Name: "Driffield", Code: "DRF"},
+	{Name: "Drigg", Code: "DRI"},
+	{Name: "Droitwich Spa", Code: "DTW"},
+	{Name: "Dronfield", Code: "DRO"},
+	{Name: "Drumchapel", Code: "DMC"},
+	{Name: "Drumfrochar", Code: "DFR"},
+	{Name: "Drumgelloch", Code: "DRU"},
+	{Name: "Drumry", Code: "DMY"},
+	{Name: "Dublin Ferryport", Code: "DFP"},
+	{Name: "Dublin Port - Stena", Code: "DPS"},
+	{Name: "Duddeston", Code: "DUD"},
+	{Name: "Dudley Port", Code: "DDP"},
+	{Name: "Duffield", Code: "DFI"},
+	{Name: "Duirinish", Code: "DRN"},
+	{Name: "Duke Street", Code: "DST"},
+	{Name: "Dullingham", Code: "DUL"},
+	{Name: "Dumbarton Central", Code: "DBC"},
+	{Name: "Dumbarton East", Code: "DBE"},
+	{Name: "Dumbreck", Code: "DUM"},
+	{Name: "Dumfries", Code: "DMF"},
+	{Name: "Dumpton Park", Code: "DMP"},
+	{Name: "Dunbar", Code: "DUN"},
+	{Name: "Dunblane", Code: "DBL"},
+	{Name: "Duncraig", Code: "DCG"},
+	{Name: "Dundee", Code: "DEE", TIPLOC: "DUNDETB", Lat: 56.4566, Lon: -2.9707, Country: Scotland},
+	{Name: "Dunfermline Queen Margaret", Code: "DFL"},
+	{Name: "Dunfermline Town", Code: "DFE"},
+	{Name: "Dunkeld & Birnam", Code: "DKD"},
+	{Name: "Dunlop", Code: "DNL"},
+	{Name: "Dunrobin Castle", Code: "DNO"},
+	{Name: "Dunston", Code: "DOT"},
+	{Name: "Dunton Green", Code: "DNG"},
+	{Name: "Durham", Code: "DHM"},
+	{Name: "Durrington-on-Sea", Code: "DUR"},
+	{Name: "Dyce", Code: "DYC"},
+	{Name: "Dyffryn Ardudwy", Code: "DYF"},
+	{Name: "Eaglescliffe", Code: "EAG"},
+	{Name: "Ealing Broadway", Code: "EAL"},
+	{Name: "Earlestown", Code: "ERL"},
+	{Name: "Earley", Code: "EAR"},
+	{Name: "Earlsfield", Code: "EAD"},
+	{Name: "Earlswood (Surrey)", Code: "ELD"},
+	{Name: "Earlswood (West Midlands)", Code: "EWD"},
+	{Name: "East Croydon", Code: "ECR"},
+	{Name: "East Didsbury", Code: "EDY"},
+	{Name: "East Dulwich", Code: "EDW"},
+	{Name: "East Farleigh", Code: "EFL"},
+	{Name: "East Garforth", Code: "EGF"},
+	{Name: "East Grinstead", Code: "EGR"},
+	{Name: "East Kilbride", Code: "EKL"},
+	{Name: "East Malling", Code: "EML"},
+	{Name: "East Midlands Parkway", Code: "EMD"},
+	{Name: "East Tilbury", Code: "ETL"},
+	{Name: "East Worthing", Code: "EWR"},
+	{Name: "Eastbourne", Code: "EBN"},
+	{Name: "Eastbrook", Code: "EBK"},
+	{Name: "Easterhouse", Code: "EST"},
+	{Name: "Eastham Rake", Code: "ERA"},
+	{Name: "Eastleigh", Code: "ESL"},
+	{Name: "Eastrington", Code: "EGN"},
+	{Name: "Ebbsfleet International", Code: "EBD"},
+	{Name: "Ebbw Vale Parkway", Code: "EBV"},
+	{Name: "Ebbw Vale Town", Code: "EBB"},
+	{Name: "Eccles (Manchester)", Code: "ECC"},
+	{Name: "Eccles Road", Code: "ECS"},
+	{Name: "Eccleston Park", Code: "ECL"},
+	{Name: "Edale", Code: "EDL"},
+	{Name: "Eden Park", Code: "EDN"},
+	{Name: "Edenbridge", Code: "EBR"},
+	{Name: "Edenbridge Town", Code: "EBT"},
+	{Name: "Edge Hill", Code: "EDG"},
+	{Name: "Edinburgh", Code: "EDB", TIPLOC: "EDINBUR", Lat: 55.9520, Lon: -3.1890, Country: Scotland},
+	{Name: "Edinburgh Gateway", Code: "EGY"},
+	{Name: "Edinburgh Park", Code: "EDP"},
+	{Name: "Edmonton Green", Code: "EDR"},
+	{Name: "Effingham Junction", Code: "EFF"},
+	{Name: "Eggesford", Code: "EGG"},
+	{Name: "Egham", Code: "EGH"},
+	{Name: "Egton", Code: "EGT"},
+	{Name: "Elephant & Castle", Code: "EPH"},
+	{Name: "Elephant & Castle (Underground)", Code: "ZEL"},
+	{Name: "Elgin", Code: "ELG"},
+	{Name: "Ellesmere Port", Code: "ELP"},
+	{Name: "Elmers End", Code: "ELE"},
+	{Name: "Elmstead Woods", Code: "ESD"},
+	{Name: "Elmswell", Code: "ESW"},
+	{Name: "Elsecar", Code: "ELR"},
+	{Name: "Elsenham (Essex)", Code: "ESM"},
+	{Name: "Elstree & Borehamwood", Code: "ELS"},
+	{Name: "Eltham", Code: "ELW"},
+	{Name: "Elton & Orston", Code: "ELO"},
+	{Name: "Ely", Code: "ELY"},
+	{Name: "Emerson Park", Code: "EMP"},
+	{Name: "Emsworth", Code: "EMS"},
+	{Name: "Energlyn & Churchill Park", Code: "ECP"},
+	{Name: "Enfield Chase", Code: "ENC"},
+	{Name: "Enfield Lock", Code: "ENL"},
+	{Name: "Enfield Town", Code: "ENF"},
+	{Name: "Entwistle", Code: "ENT"},
+	{Name: "Epsom (Surrey)", Code: "EPS"},
+	{Name: "Epsom Downs", Code: "EPD"},
+	{Name: "Erdington", Code: "ERD"},
+	{Name: "Eridge", Code: "ERI"},
+	{Name: "Erith", Code: "ERH"},
+	{Name: "Esher", Code: "ESH"},
+	{Name: "Eskbank", Code: "EKB"},
+	{Name: "Essex Road", Code: "EXR"},
+	{Name: "Etchingham", Code: "ETC"},
+	{Name: "Euxton Balshaw Lane", Code: "EBA"},
+	{Name: "Evesham", Code: "EVE"},
+	{Name: "Ewell East", Code: "EWE"},
+	{Name: "Ewell West", Code: "EWW"},
+	{Name: "Exeter Central", Code: "EXC"},
+	{Name: "Exeter St David's", Code: "EXD", TIPLOC: "EXETRSD", Lat: 50.7291, Lon: -3.5434, Country: England},
+	{Name: "Exeter St Thomas", Code: "EXT"},
+	{Name: "Exhibition Centre (Glasgow)", Code: "EXG"},
+	{Name: "Exmouth", Code: "EXM"},
+	{Name: "Exton", Code: "EXN"},
+	{Name: "Eynsford", Code: "EYN"},
+	{Name: "Fairbourne", Code: "FRB"},
+	{Name: "Fairfield", Code: "FRF"},
+	{Name: "Fairlie", Code: "FRL"},
+	{Name: "Fairwater", Code: "FRW"},
+	{Name: "Falconwood", Code: "FCN"},
+	{Name: "Falkirk Grahamston", Code: "FKG"},
+	{Name: "Falkirk High", Code: "FKK"},
+	{Name: "Falls of Cruachan", Code: "FOC"},
+	{Name: "Falmer", Code: "FMR"},
+	{Name: "Falmouth Docks", Code: "FAL"},
+	{Name: "Falmouth Town", Code: "FMT"},
+	{Name: "Fareham", Code: "FRM"},
+	{Name: "Farnborough (Main)", Code: "FNB"},
+	{Name: "Farnborough North", Code: "FNN"},
+	{Name: "Farncombe", Code: "FNC"},
+	{Name: "Farnham", Code: "FNH"},
+	{Name: "Farningham Road", Code: "FNR"},
+	{Name: "Farnworth", Code: "FNW"},
+	{Name: "Farringdon", Code: "ZFD"},
+	{Name: "Fauldhouse", Code: "FLD"},
+	{Name: "Faversham", Code: "FAV"},
+	{Name: "Faygate", Code: "FGT"},
+	{Name: "Fazakerley", Code: "FAZ"},
+	{Name: "Fearn", Code: "FRN"},
+	{Name: "Featherstone", Code: "FEA"},
+	{Name: "Felixstowe", Code: "FLX"},
+	{Name: "Feltham", Code: "FEL"},
+	{Name: "Feniton", Code: "FNT"},
+	{Name: "Fenny Stratford", Code: "FEN"},
+	{Name: "Fernhill", Code: "FER"},
+	{Name: "Ferriby", Code: "FRY"},
+	{Name: "Ferryside", Code: "FYS"},
+	{Name: "Ffairfach", Code: "FFA"},
+	{Name: "Filey", Code: "FIL"},
+	{Name: "Filton Abbey Wood", Code: "FIT"},
+	{Name: "Finchley Road & Frognal", Code: "FNY"},
+	{Name: "Finsbury Park", Code: "FPK"},
+	{Name: "Finstock", Code: "FIN"},
+	{Name: "Fishbourne (Sussex)", Code: "FSB"},
+	{Name: "Fishersgate", Code: "FSG"},
+	{Name: "Fishguard & Goodwick", Code: "FGW"},
+	{Name: "Fishguard Harbour", Code: "FGH"},
+	{Name: "Fiskerton", Code: "FSK"},
+	{Name: "Fitzwilliam", Code: "FZW"},
+	{Name: "Five Ways", Code: "FWY"},
+	{Name: "Fleet", Code: "FLE"},
+	{Name: "Flimby", Code: "FLM"},
+	{Name: "Flint", Code: "FLN"},
+	{Name: "Flitwick", Code: "FLT"},
+	{Name: "Flixton", Code: "FLI"},
+	{Name: "Flowery Field", Code: "FLF"},
+	{Name: "Folkestone Central", Code: "FKC"},
+	{Name: "Folkestone West", Code: "FKW"},
+	{Name: "Ford", Code: "FOD"},
+	{Name: "Forest Gate", Code: "FOG"},
+	{Name: "Forest Hill", Code: "FOH"},
+	{Name: "Formby", Code: "FBY"},
+	{Name: "Forres", Code: "FOR"},
+	{Name: "Forsinard", Code: "FRS"},
+	{Name: "Fort Matilda", Code: "FTM"},
+	{Name: "Fort William", Code: "FTW"},
+	{Name: "Four Oaks", Code: "FOK"},
+	{Name: "Foxfield", Code: "FOX"},
+	{Name: "Foxton", Code: "FXN"},
+	{Name: "Frant", Code: "FRT"},
+	{Name: "Fratton", Code: "FTN"},
+	{Name: "Freshfield", Code: "FRE"},
+	{Name: "Freshford", Code: "FFD"},
+	{Name: "Frimley", Code: "FML"},
+	{Name: "Frinton-on-Sea", Code: "FRI"},
+	{Name: "Frizinghall", Code: "FZH"},
+	{Name: "Frodsham", Code: "FRD"},
+	{Name: "Frome", Code: "FRO"},
+	{Name: "Fulwell", Code: "FLW"},
+	{Name: "Furness Vale", Code: "FNV"},
+	{Name: "Furze Platt", Code: "FZP"},
+	{Name: "Gainsborough Central", Code: "GNB"},
+	{Name: "Gainsborough Lea Road", Code: "GBL"},
+	{Name: "Galashiels", Code: "GAL"},
+	{Name: "Garelochhead", Code: "GCH"},
+	{Name: "Garforth", Code: "GRF"},
+	{Name: "Gargrave", Code: "GGV"},
+	{Name: "Garrowhill", Code: "GAR"},
+	{Name: "Garscadden", Code: "GRS"},
+	{Name: "Garsdale", Code: "GSD"},
+	{Name: "Garston (Hertfordshire)", Code: "GSN"},
+	{Name: "Garswood", Code: "GSW"},
+	{Name: "Gartcosh", Code: "GRH"},
+	{Name: "Garth (Mid Glamorgan)", Code: "GMG"},
+	{Name: "Garth (Powys)", Code: "GTH"},
+	{Name: "Garve", Code: "GVE"},
+	{Name: "Gathurst", Code: "GST"},
+	{Name: "Gatley", Code: "GTY"},
+	{Name: "Gatwick Airport", Code: "GTW"},
+	{Name: "Georgemas Junction", Code: "GGJ"},
+	{Name: "Gerrards Cross", Code: "GER"},
+	{Name: "Gidea Park", Code: "GDP"},
+	{Name: "Giffnock", Code: "GFN"},
+	{Name: "Giggleswick", Code: "GIG"},
+	{Name: "Gilberdyke", Code: "GBD"},
+	{Name: "Gilfach Fargoed", Code: "GFF"},
+	{Name: "Gillingham (Dorset)", Code: "GIL"},
+	{Name: "Gillingham (Kent)", Code: "GLM"},
+	{Name: "Gilshochill", Code: "GSC"},
+	{Name: "Gipsy Hill", Code: "GIP"},
+	{Name: "Girvan", Code: "GIR"},
+	{Name: "Glaisdale", Code: "GLS"},
+	{Name: "Glan Conwy", Code: "GCW"},
+	{Name: "Glasgow Central", Code: "GLC", TIPLOC: "GLGC", Lat: 55.8590, Lon: -4.2576, Country: Scotland},
+	{Name: "Glasgow Queen Street", Code: "GLQ"},
+	{Name: "Glasshoughton", Code: "GLH"},
+	{Name: "Glazebrook", Code: "GLZ"},
+	{Name: "Gleneagles", Code: "GLE"},
+	{Name: "Glenfinnan", Code: "GLF"},
+	{Name: "Glengarnock", Code: "GLG"},
+	{Name: "Glenrothes with Thornton", Code: "GLT"},
+	{Name: "Glossop", Code: "GLO"},
+	{Name: "Gloucester", Code: "GCR"},
+	{Name: "Glynde", Code: "GLY"},
+	{Name: "Gobowen", Code: "GOB"},
+	{Name: "Godalming", Code: "GOD"},
+	{Name: "Godley", Code: "GDL"},
+	{Name: "Godstone", Code: "GDN"},
+	{Name: "Goldthorpe", Code: "GOE"},
+	{Name: "Golf Street", Code: "GOF"},
+	{Name: "Golspie", Code: "GOL"},
+	{Name: "Gomshall", Code: "GOM"},
+	{Name: "Goodmayes", Code: "GMY"},
+	{Name: "Goole", Code: "GOO"},
+	{Name: "Goostrey", Code: "GTR"},
+	{Name: "Gordon Hill", Code: "GDH"},
+	{Name: "Gorebridge", Code: "GBG"},
+	{Name: "Goring & Streatley", Code: "GOR"},
+	{Name: "Goring-by-Sea", Code: "GBS"},
+	{Name: "Gorton", Code: "GTO"},
+	{Name: "Gospel Oak", Code: "GPO"},
+	{Name: "Gourock", Code: "GRK"},
+	{Name: "Gowerton", Code: "GWN"},
+	{Name: "Goxhill", Code: "GOX"},
+	{Name: "Grange Park", Code: "GPK"},
+	{Name: "Grange-Over-Sands", Code: "GOS"},
+	{Name: "Grangetown (Cardiff)", Code: "GTN"},
+	{Name: "Grantham", Code: "GRA"},
+	{Name: "Grateley", Code: "GRT"},
+	{Name: "Gravelly Hill", Code: "GVH"},
+	{Name: "Gravesend", Code: "GRV"},
+	{Name: "Grays", Code: "GRY"},
+	{Name: "Great Ayton", Code: "GTA"},
+	{Name: "Great Bentley", Code: "GRB"},
+	{Name: "Great Chesterford", Code: "GRC"},
+	{Name: "Great Coates", Code: "GCT"},
+	{Name: "Great Malvern", Code: "GMV"},
+	{Name: "Great Missenden", Code: "GMN"},
+	{Name: "Great Yarmouth", Code: "GYM"},
+	{Name: "Green Lane", Code: "GNL"},
+	{Name: "Green Road", Code: "GNR"},
+	{Name: "Greenbank", Code: "GBK"},
+	{Name: "Greenfaulds", Code: "GRL"},
+	{Name: "Greenfield", Code: "GNF"},
+	{Name: "Greenford", Code: "GFD"},
+	{Name: "Greenhithe", Code: "GNH"},
+	{Name: "Greenock Central", Code: "GKC"},
+	{Name: "Greenock West", Code: "GKW"},
+	{Name: "Greenwich", Code: "GNW"},
+	{Name: "Gretna Green", Code: "GEA"},
+	{Name: "Grimsby Docks", Code: "GMD"},
+	{Name: "Grimsby Town", Code: "GMB"},
+	{Name: "Grindleford", Code: "GRN"},
+	{Name: "Grosmont", Code: "GMT"},
+	{Name: "Grove Park", Code: "GRP"},
+	{Name: "Guide Bridge", Code: "GUI"},
+	{Name: "Guildford", Code: "GLD"},
+	{Name: "Guiseley", Code: "GSY"},
+	{Name: "Gunnersbury", Code: "GUN"},
+	{Name: "Gunnislake", Code: "GSL"},
+	{Name: "Gunton", Code: "GNT"},
+	{Name: "Gwersyllt", Code: "GWE"},
+	{Name: "Gypsy Lane", Code: "GYP"},
+	{Name: "Habrough", Code: "HAB"},
+	{Name: "Hackbridge", Code: "HCB"},
+	{Name: "Hackney Central", Code: "HKC"},
+	{Name: "Hackney Downs", Code: "HAC"},
+	{Name: "Hackney Wick", Code: "HKW"},
+	{Name: "Haddenham & Thame Parkway", Code: "HDM"},
+	{Name: "Haddiscoe", Code: "HAD"},
+	{Name: "Hadfield", Code: "HDF"},
+	{Name: "Hadley Wood", Code: "HDW"},
+	{Name: "Hag Fold", Code: "HGF"},
+	{Name: "Haggerston", Code: "HGG"},
+	{Name: "Hagley", Code: "HAG"},
+	{Name: "Hairmyres", Code: "HMY"},
+	{Name: "Hale (Manchester)", Code: "HAL"},
+	{Name: "Halesworth", Code: "HAS"},
+	{Name: "Halewood", Code: "HED"},
+	{Name: "Halifax", Code: "HFX"},
+	{Name: "Hall Green", Code: "HLG"},
+	{Name: "Hall Road", Code: "HLR"},
+	{Name: "Halling", Code: "HAI"},
+	{Name: "Hall-i'-th'-Wood", Code: "HID"},
+	{Name: "Haltwhistle", Code: "HWH"},
+	{Name: "Ham Street", Code: "HMT"},
+	{Name: "Hamble", Code: "HME"},
+	{Name: "Hamilton Central", Code: "HNC"},
+	{Name: "Hamilton West", Code: "HNW"},
+	{Name: "Hammerton", Code: "HMM"},
+	{Name: "Hampden Park (Sussex)", Code: "HMD"},
+	{Name: "Hampstead Heath", Code: "HDH"},
+	{Name: "Hampton (London)", Code: "HMP"},
+	{Name: "Hampton Court", Code: "HMC"},
+	{Name: "Hampton Wick", Code: "HMW"},
+	{Name: "Hampton-in-Arden", Code: "HIA"},
+	{Name: "Hamstead (Birmingham)", Code: "HSD"},
+	{Name: "Hamworthy", Code: "HAM"},
+	{Name: "Hanborough", Code: "HND"},
+	{Name: "Handforth", Code: "HTH"},
+	{Name: "Hanwell", Code: "HAN"},
+	{Name: "Hapton", Code: "HPN"},
+	{Name: "Harlech", Code: "HRL"},
+	{Name: "Harlesden", Code: "HDN"},
+	{Name: "Harling Road", Code: "HRD"},
+	{Name: "Harlington (Beds)", Code: "HLN"},
+	{Name: "Harlow Mill", Code: "HWM"},
+	{Name: "Harlow Town", Code: "HWN"},
+	{Name: "Harold Wood", Code: "HRO"},
+	{Name: "Harpenden", Code: "HPD"},
+	{Name: "Harrietsham", Code: "HRM"},
+	{Name: "Harringay", Code: "HGY"},
+	{Name: "Harringay Green Lanes", Code: "HRY"},
+	{Name: "Harrington", Code: "HRR"},
+	{Name: "Harrogate", Code: "HGT"},
+	{Name: "Harrow & Wealdstone", Code: "HRW"},
+	{Name: "Harrow-on-the-Hill", Code: "HOH"},
+	{Name: "Hartford (Cheshire)", Code: "HTF"},
+	{Name: "Hartlebury", Code: "HBY"},
+	{Name: "Hartlepool", Code: "HPL"},
+	{Name: "Hartwood", Code: "HTW"},
+	{Name: "Harwich International", Code: "HPQ"},
+	{Name: "Harwich Town", Code: "HWC"},
+	{Name: "Haslemere", Code: "HSL"},
+	{Name: "Hassocks", Code: "HSK"},
+	{Name: "Hastings", Code: "HGS"},
+	{Name: "Hatch End", Code: "HTE"},
+	{Name: "Hatfield & Stainforth", Code: "HFS"},
+	{Name: "Hatfield (Herts)", Code: "HAT"},
+	{Name: "Hatfield Peverel", Code: "HAP"},
+	{Name: "Hathersage", Code: "HSG"},
+	{Name: "Hattersley", Code: "HTY"},
+	{Name: "Hatton", Code: "HTN"},
+	{Name: "Havant", Code: "HAV"},
+	{Name: "Havenhouse", Code: "HVN"},
+	{Name: "Haverfordwest", Code: "HVF"},
+	{Name: "Hawarden", Code: "HWD"},
+	{Name: "Hawarden Bridge", Code: "HWB"},
+	{Name: "Hawkhead", Code: "HKH"},
+	{Name: "Haydon Bridge", Code: "HDB"},
+	{Name: "Haydons Road", Code: "HYR"},
+	{Name: "Hayes & Harlington", Code: "HAY"},
+	{Name: "Hayes (Kent)", Code: "HYS"},
+	{Name: "Hayle", Code: "HYL"},
+	{Name: "Haymarket", Code: "HYM"},
+	{Name: "Haywards Heath", Code: "HHE"},
+	{Name: "Hazel Grove", Code: "HAZ"},
+	{Name: "Headcorn", Code: "HCN"},
+	{Name: "Headingley", Code: "HDY"},
+	{Name: "Headstone Lane", Code: "HDL"},
+	{Name: "Heald Green", Code: "HDG"},
+	{Name: "Healing", Code: "HLI"},
+	{Name: "Heath High Level", Code: "HHL"},
+	{Name: "Heath Low Level", Code: "HLL"},
+	{Name: "Heathrow Airport Terminal 4", Code: "HAF"},
+	{Name: "Heathrow Airport Terminal 5", Code: "HWV"},
+	{Name: "Heathrow Airport Terminals 1, 2 and 3", Code: "HXX"},
+	{Name: "Heaton Chapel", Code: "HTC"},
+	{Name: "Hebden Bridge", Code: "HBD"},
+	{Name: "Heckington", Code: "HEC"},
+	{Name: "Hedge End", Code: "HDE"},
+	{Name: "Hednesford", Code: "HNF"},
+	{Name: "Heighington", Code: "HEI"},
+	{Name: "Helensburgh Central", Code: "HLC"},
+	{Name: "Helensburgh Upper", Code: "HLU"},
+	{Name: "Hellifield", Code: "HLD"},
+	{Name: "Helmsdale", Code: "HMS"},
+	{Name: "Helsby", Code: "HSB"},
+	{Name: "Hemel Hempstead", Code: "HML"},
+	{Name: "Hendon", Code: "HEN"},
+	{Name: "Hengoed", Code: "HNG"},
+	{Name: "Henley-in-Arden", Code: "HNL"},
+	{Name: "Henley-on-Thames", Code: "HOT"},
+	{Name: "Hensall", Code: "HEL"},
+	{Name: "Hereford", Code: "HFD"},
+	{Name: "Herne Bay", Code: "HNB"},
+	{Name: "Herne Hill", Code: "HNH"},
+	{Name: "Hersham", Code: "HER"},
+	{Name: "Hertford East", Code: "HFE"},
+	{Name: "Hertford North", Code: "HFN"},
+	{Name: "Hessle", Code: "HES"},
+	{Name: "Heswall", Code: "HSW"},
+	{Name: "Hever", Code: "HEV"},
+	{Name: "Heworth", Code: "HEW"},
+	{Name: "Hexham", Code: "HEX"},
+	{Name: "Heyford", Code: "HYD"},
+	{Name: "Heysham Port", Code: "HHB"},
+	{Name: "High Brooms", Code: "HIB"},
+	{Name: "High Street (Glasgow)", Code: "HST"},
+	{Name: "High Street Kensington Underground", Code: "ZHS"},
+	{Name: "High Wycombe", Code: "HWY"},
+	{Name: "Higham (Kent)", Code: "HGM"},
+	{Name: "Highams Park", Code: "HIP"},
+	{Name: "Highbridge & Burnham", Code: "HIG"},
+	{Name: "Highbury & Islington", Code: "HHY"},
+	{Name: "Hightown", Code: "HTO"},
+	{Name: "Hildenborough", Code: "HLB"},
+	{Name: "Hillfoot", Code: "HLF"},
+	{Name: "Hillington East", Code: "HLE"},
+	{Name: "Hillington West", Code: "HLW"},
+	{Name: "Hillside", Code: "HIL"},
+	{Name: "Hilsea", Code: "HLS"},
+	{Name: "Hinchley Wood", Code: "HYW"},
+	{Name: "Hinckley (Leics)", Code: "HNK"},
+	{Name: "Hindley", Code: "HIN"},
+	{Name: "Hinton Admiral", Code: "HNA"},
+	{Name: "Hitchin", Code: "HIT"},
+	{Name: "Hither Green", Code: "HGR"},
+	{Name: "Hockley", Code: "HOC"},
+	{Name: "Hollingbourne", Code: "HBN"},
+	{Name: "Holmes Chapel", Code: "HCH"},
+	{Name: "Holmwood", Code: "HLM"},
+	{Name: "Holton Heath", Code: "HOL"},
+	{Name: "Holyhead", Code: "HHD"},
+	{Name: "Holytown", Code: "HLY"},
+	{Name: "Homerton", Code: "HMN"},
+	{Name: "Honeybourne", Code: "HYB"},
+	{Name: "Honiton", Code: "HON"},
+	{Name: "Honley", Code: "HOY"},
+	{Name: "Honor Oak Park", Code: "HPA"},
+	{Name: "Hook", Code: "HOK"},
+	{Name: "Hooton", Code: "HOO"},
+	{Name: "Hope (Derbyshire)", Code: "HOP"},
+	{Name: "Hope (Flintshire)", Code: "HPE"},
+	{Name: "Hopton Heath", Code: "HPT"},
+	{Name: "Horden", Code: "HRE"},
+	{Name: "Horley", Code: "HOR"},
+	{Name: "Hornbeam Park", Code: "HBP"},
+	{Name: "Hornsey", Code: "HRN"},
+	{Name: "Horsforth", Code: "HRS"},
+	{Name: "Horsham", Code: "HRH"},
+	{Name: "Horsley", Code: "HSY"},
+	{Name: "Horton-in-Ribblesdale", Code: "HIR"},
+	{Name: "Horwich Parkway", Code: "HWI"},
+	{Name: "Hoscar", Code: "HSC"},
+	{Name: "Hough Green", Code: "HGN"},
+	{Name: "Hounslow", Code: "HOU"},
+	{Name: "Hove", Code: "HOV"},
+	{Name: "Hoveton & Wroxham", Code: "HXM"},
+	{Name: "How Wood (Herts)", Code: "HWW"},
+	{Name: "Howden", Code: "HOW"},
+	{Name: "Howwood (Renfrewshire)", Code: "HOZ"},
+	{Name: "Hoxton", Code: "HOX"},
+	{Name: "Hoylake", Code: "HYK"},
+	{Name: "Hubberts Bridge", Code: "HBB"},
+	{Name: "Hucknall", Code: "HKN"},
+	{Name: "Huddersfield", Code: "HUD", TIPLOC: "HDRSFLD", Lat: 53.6485, Lon: -1.7847, Country: England},
+	{Name: "Hull", Code: "HUL", TIPLOC: "HULL", Lat: 53.7442, Lon: -0.3457, Country: England},
+	{Name: "Humphrey Park", Code: "HUP"},
+	{Name: "Huncoat", Code: "HCT"},
+	{Name: "Hungerford", Code: "HGD"},
+	{Name: "Hunmanby", Code: "HUB"},
+	{Name: "Huntingdon", Code: "HUN"},
+	{Name: "Huntly", Code: "HNT"},
+	{Name: "Hunts Cross", Code: "HNX"},
+	{Name: "Hurst Green", Code: "HUR"},
+	{Name: "Hutton Cranswick", Code: "HUT"},
+	{Name: "Huyton", Code: "HUY"},
+	{Name: "Hyde Central", Code: "HYC"},
+	{Name: "Hyde North", Code: "HYT"},
+	{Name: "Hykeham", Code: "HKM"},
+	{Name: "Hyndland", Code: "HYN"},
+	{Name: "Hythe (Essex)", Code: "HYH"},
+	{Name: "Ifield", Code: "IFI"},
+	{Name: "Ilford", Code: "IFD"},
+	{Name: "Ilkeston", Code: "ILN"},
+	{Name: "Ilkley", Code: "ILK"},
+	{Name: "Imperial Wharf", Code: "IMW"},
+	{Name: "Ince & Elton", Code: "INE"},
+	{Name: "Ince (Manchester)", Code: "INC"},
+	{Name: "Ingatestone", Code: "INT"},
+	{Name: "Insch", Code: "INS"},
+	{Name: "Invergordon", Code: "IGD"},
+	{Name: "Invergowrie", Code: "ING"},
+	{Name: "Inverkeithing", Code: "INK"},
+	{Name: "Inverkip", Code: "INP"},
+	{Name: "Inverness", Code: "INV", TIPLOC: "IVRNESS", Lat: 57.4799, Lon: -4.2234, Country: Scotland},
+	{Name: "Invershin", Code: "INH"},
+	{Name: "Inverurie", Code: "INR"},
+	{Name: "Ipswich", Code: "IPS", TIPLOC: "IPSWICH", Lat: 52.0506, Lon: 1.1445, Country: England},
+	{Name: "Irlam", Code: "IRL"},
+	{Name: "Irvine", Code: "IRV"},
+	{Name: "Isleworth", Code: "ISL"},
+	{Name: "Islip", Code: "ISP"},
+	{Name: "Iver", Code: "IVR"},
+	{Name: "Ivybridge", Code: "IVY"},
+	{Name: "James Cook", Code: "JCH"},
+	{Name: "Jewellery Quarter", Code: "JEQ"},
+	{Name: "Johnston (Pembs)", Code: "JOH"},
+	{Name: "Johnstone (Strathclyde)", Code: "JHN"},
+	{Name: "Jordanhill", Code: "JOR"},
+	{Name: "Kearsley (Manchester)", Code: "KSL"},
+	{Name: "Kearsney (Kent)", Code: "KSN"},
+	{Name: "Keighley", Code: "KEI"},
+	{Name: "Keith", Code: "KEH"},
+	{Name: "Kelvedon", Code: "KEL"},
+	{Name: "Kelvindale", Code: "KVD"},
+	{Name: "Kemble", Code: "KEM"},
+	{Name: "Kempston Hardwick", Code: "KMH"},
+	{Name: "Kempton Park Racecourse", Code: "KMP"},
+	{Name: "Kemsing", Code: "KMS"},
+	{Name: "Kemsley", Code: "KML"},
+	{Name: "Kendal", Code: "KEN"},
+	{Name: "Kenilworth", Code: "KNW"},
+	{Name: "Kenley", Code: "KLY"},
+	{Name: "Kennett", Code: "KNE"},
+	{Name: "Kennishead", Code: "KNS"},
+	{Name: "Kensal Green", Code: "KNL"},
+	{Name: "Kensal Rise", Code: "KNR"},
+	{Name: "Kensington Olympia", Code: "KPA"},
+	{Name: "Kent House", Code: "KTH"},
+	{Name: "Kentish Town", Code: "KTN"},
+	{Name: "Kentish Town West", Code: "KTW"},
+	{Name: "Kenton", Code: "KNT"},
+	{Name: "Kents Bank", Code: "KBK"},
+	{Name: "Kettering", Code: "KET"},
+	{Name: "Kew Bridge", Code: "KWB"},
+	{Name: "Kew Gardens", Code: "KWG"},
+	{Name: "Keyham", Code: "KEY"},
+	{Name: "Keynsham", Code: "KYN"},
+	{Name: "Kidbrooke", Code: "KDB"},
+	{Name: "Kidderminster", Code: "KID"},
+	{Name: "Kidsgrove", Code: "KDG"},
+	{Name: "Kidwelly", Code: "KWL"},
+	{Name: "Kilburn High Road", Code: "KBN"},
+	{Name: "Kildale", Code: "KLD"},
+	{Name: "Kildonan", Code: "KIL"},
+	{Name: "Kilgetty", Code: "KGT"},
+	{Name: "Kilmarnock", Code: "KMK"},
+	{Name: "Kilmaurs", Code: "KLM"},
+	{Name: "Kilpatrick", Code: "KPT"},
+	{Name: "Kilwinning", Code: "KWN"},
+	{Name: "Kinbrace", Code: "KBC"},
+	{Name: "Kingham", Code: "KGM"},
+	{Name: "Kinghorn", Code: "KGH"},
+	{Name: "Kings Langley", Code: "KGL"},
+	{Name: "Kings Lynn", Code: "KLN"},
+	{Name: "Kings Norton", Code: "KNN"},
+	{Name: "Kings Nympton", Code: "KGN"},
+	{Name: "Kings Park", Code: "KGP"},
+	{Name: "Kings Sutton", Code: "KGS"},
+	{Name: "Kingsknowe", Code: "KGE"},
+	{Name: "Kingston", Code: "KNG"},
+	{Name: "Kingswood", Code: "KND"},
+	{Name: "Kingussie", Code: "KIN"},
+	{Name: "Kintbury", Code: "KIT"},
+	{Name: "Kirby Cross", Code: "KBX"},
+	{Name: "Kirk Sandall", Code: "KKS"},
+	{Name: "Kirkby (Merseyside)", Code: "KIR"},
+	{Name: "Kirkby Stephen", Code: "KSW"},
+	{Name: "Kirkby-in-Ashfield", Code: "KKB"},
+	{Name: "Kirkby-in-Furness", Code: "KBF"},
+	{Name: "Kirkcaldy", Code: "KDY"},
+	{Name: "Kirkconnel", Code: "KRK"},
+	{Name: "Kirkdale", Code: "KKD"},
+	{Name: "Kirkham & Wesham", Code: "KKM"},
+	{Name: "Kirkhill", Code: "KKH"},
+	{Name: "Kirknewton", Code: "KKN"},
+	{Name: "Kirkstall Forge", Code: "KLF"},
+	{Name: "Kirkwood", Code: "KWD"},
+	{Name: "Kirton Lindsey", Code: "KTL"},
+	{Name: "Kiveton Bridge", Code: "KIV"},
+	{Name: "Kiveton Park", Code: "KVP"},
+	{Name: "Knaresborough", Code: "KNA"},
+	{Name: "Knebworth", Code: "KBW"},
+	{Name: "Knighton", Code: "KNI"},
+	{Name: "Knockholt", Code: "KCK"},
+	{Name: "Knottingley", Code: "KNO"},
+	{Name: "Knucklas", Code: "KNU"},
+	{Name: "Knutsford", Code: "KNF"},
+	{Name: "Kyle of Lochalsh", Code: "KYL"},
+	{Name: "Ladybank", Code: "LDY"},
+	{Name: "Ladywell", Code: "LAD"},
+	{Name: "Laindon", Code: "LAI"},
+	{Name: "Lairg", Code: "LRG"},
+	{Name: "Lake", Code: "LKE"},
+	{Name: "Lakenheath", Code: "LAK"},
+	{Name: "Lamphey", Code: "LAM"},
+	{Name: "Lanark", Code: "LNK"},
+	{Name: "Lancaster", Code: "LAN"},
+	{Name: "Lancing", Code: "LAC"},
+	{Name: "Landywood", Code: "LAW"},
+	{Name: "Langbank", Code: "LGB"},
+	{Name: "Langho", Code: "LHO"},
+	{Name: "Langley (Berks)", Code: "LNY"},
+	{Name: "Langley Green", Code: "LGG"},
+	{Name: "Langley Mill", Code: "LGM"},
+	{Name: "Langside", Code: "LGS"},
+	{Name: "Langwathby", Code: "LGW"},
+	{Name: "Langwith-Whaley Thorns", Code: "LAG"},
+	{Name: "Lapford", Code: "LAP"},
+	{Name: "Lapworth", Code: "LPW"},
+	{Name: "Larbert", Code: "LBT"},
+	{Name: "Largs", Code: "LAR"},
+	{Name: "Larkhall", Code: "LRH"},
+	{Name: "Laurencekirk", Code: "LAU"},
+	{Name: "Lawrence Hill", Code: "LWH"},
+	{Name: "Layton (Lancs)", Code: "LAY"},
+	{Name: "Lazonby & Kirkoswald", Code: "LZB"},
+	{Name: "Lea Bridge", Code: "LEB"},
+	{Name: "Lea Green", Code: "LEG"},
+	{Name: "Lea Hall", Code: "LEH"},
+	{Name: "Leagrave", Code: "LEA"},
+	{Name: "Lealholm", Code: "LHM"},
+	{Name: "Leamington Spa", Code: "LMS"},
+	{Name: "Leasowe", Code: "LSW"},
+	{Name: "Leatherhead", Code: "LHD"},
+	{Name: "Ledbury", Code: "LED"},
+	{Name: "Lee (London)", Code: "LEE"},
+	{Name: "Leeds", Code: "LDS", TIPLOC: "LEEDS", Lat: 53.7955, Lon: -1.5480, Country: England},
+	{Name: "Leicester", Code: "LEI", TIPLOC: "LESTER", Lat: 52.6313, Lon: -1.1253, Country: England},
+	{Name: "Leigh (Kent)", Code: "LIH"},
+	{Name: "Leigh-on-Sea", Code: "LES"},
+	{Name: "Leighton Buzzard", Code: "LBZ"},
+	{Name: "Lelant", Code: "LEL"},
+	{Name: "Lelant Saltings", Code: "LTS"},
+	{Name: "Lenham", Code: "LEN"},
+	{Name: "Lenzie", Code: "LNZ"},
+	{Name: "Leominster", Code: "LEO"},
+	{Name: "Letchworth Garden City", Code: "LET"},
+	{Name: "Leuchars (for St. Andrews)", Code: "LEU"},
+	{Name: "Levenshulme", Code: "LVM"},
+	{Name: "Lewes", Code: "LWS"},
+	{Name: "Lewisham", Code: "LEW"},
+	{Name: "Leyland", Code: "LEY"},
+	{Name: "Leyton Midland Road", Code: "LEM"},
+	{Name: "Leytonstone High Road", Code: "LER"},
+	{Name: "Lichfield City", Code: "LIC"},
+	{Name: "Lichfield Trent Valley", Code: "LTV"},
+	{Name: "Lidlington", Code: "LID"},
+	{Name: "Limehouse", Code: "LHS"},
+	{Name: "Lincoln Central", Code: "LCN"},
+	{Name: "Lingfield", Code: "LFD"},
+	{Name: "Lingwood", Code: "LGD"},
+	{Name: "Linlithgow", Code: "LIN"},
+	{Name: "Liphook", Code: "LIP"},
+	{Name: "Liskeard", Code: "LSK"},
+	{Name: "Liss", Code: "LIS"},
+	{Name: "Lisvane & Thornhill", Code: "LVT"},
+	{Name: "Little Kimble", Code: "LTK"},
+	{Name: "Little Sutton", Code: "LTT"},
+	{Name: "Littleborough", Code: "LTL"},
+	{Name: "Littlehampton", Code: "LIT"},
+	{Name: "Littlehaven", Code: "LVN"},
+	{Name: "Littleport", Code: "LTP"},
+	{Name: "Liverpool Central", Code: "LVC"},
+	{Name: "Liverpool James Street", Code: "LVJ"},
+	{Name: "Liverpool Lime Street", Code: "LIV", TIPLOC: "LVRPLSH", Lat: 53.4075, Lon: -2.9774, Country: England},
+	{Name: "Liverpool South Parkway", Code: "LPY"},
+	{Name: "Livingston North", Code: "LSN"},
+	{Name: "Livingston South", Code: "LVG"},
+	{Name: "Llanaber", Code: "LLA"},
+	{Name: "Llanbedr", Code: "LBR"},
+	{Name: "Llanbister Road", Code: "LLT"},
+	{Name: "Llanbradach", Code: "LNB"},
+	{Name: "Llandaf", Code: "LLN"},
+	{Name: "Llandanwg", Code: "LDN"},
+	{Name: "Llandecwyn", Code: "LLC"},
+	{Name: "Llandeilo", Code: "LLL"},
+	{Name: "Llandovery", Code: "LLV"},
+	{Name: "Llandrindod", Code: "LLO"},
+	{Name: "Llandudno", Code: "LLD"},
+	{Name: "Llandudno Junction", Code: "LLJ"},
+	{Name: "Llandybie", Code: "LLI"},
+	{Name: "Llanelli", Code: "LLE"},
+	{Name: "Llanfairfechan", Code: "LLF"},
+	{Name: "Llanfairpwll", Code: "LPG"},
+	{Name: "Llangadog", Code: "LLG"},
+	{Name: "Llangammarch", Code: "LLM"},
+	{Name: "Llangennech", Code: "LLH"},
+	{Name: "Llangynllo", Code: "LGO"},
+	{Name: "Llanharan", Code: "LLR"},
+	{Name: "Llanhilleth", Code: "LTH"},
+	{Name: "Llanishen", Code: "LLS"},
+	{Name: "Llanrwst", Code: "LWR"},
+	{Name: "Llansamlet", Code: "LAS"},
+	{Name: "Llantwit Major", Code: "LWM"},
+	{Name: "Llanwrda", Code: "LNR"},
+	{Name: "Llanwrtyd", Code: "LNW"},
+	{Name: "Llwyngwril", Code: "LLW"},
+	{Name: "Llwynypia", Code: "LLY"},
+	{Name: "Loch Awe", Code: "LHA"},
+	{Name: "Loch Eil Outward Bound", Code: "LHE"},
+	{Name: "Lochailort", Code: "LCL"},
+	{Name: "Locheilside", Code: "LCS"},
+	{Name: "Lochgelly", Code: "LCG"},
+	{Name: "Lochluichart", Code: "LCC"},
+	{Name: "Lochwinnoch", Code: "LHW"},
+	{Name: "Lockerbie", Code: "LOC"},
+	{Name: "Lockwood", Code: "LCK"},
+	{Name: "London Blackfriars", Code: "BFR"},
+	{Name: "London Bridge", Code: "LBG", TIPLOC: "LNDNBDE", Lat: 51.5052, Lon: -0.0864, Country: England},
+	{Name: "London Cannon Street", Code: "CST", TIPLOC: "CANONST", Lat: 51.5113, Lon: -0.0904, Country: England},
+	{Name: "London Charing Cross", Code: "CHX", TIPLOC: "CHRX", Lat: 51.5080, Lon: -0.1247, Country: England},
+	{Name: "London Euston", Code: "EUS", TIPLOC: "EUSTON", Lat: 51.5282, Lon: -0.1337, Country: England},
+	{Name: "London Fenchurch Street", Code: "FST", TIPLOC: "FENCHRS", Lat: 51.5117, Lon: -0.0789, Country: England},
+	{Name: "London Fields", Code: "LOF"},
+	{Name: "London Kings Cross", Code: "KGX", TIPLOC: "KNGX", Lat: 51.5308, Lon: -0.1238, Country: England},
+	{Name: "London Liverpool Street", Code: "LST", TIPLOC: "LIVST", Lat: 51.5178, Lon: -0.0817, Country: England},
+	{Name: "London Marylebone", Code: "MYB", TIPLOC: "MRYLBN", Lat: 51.5225, Lon: -0.1631, Country: England},
+	{Name: "London Paddington", Code: "PAD", TIPLOC: "PADTON", Lat: 51.5154, Lon: -0.1755, Country: England},
+	{Name: "London Road (Brighton)", Code: "LRB"},
+	{Name: "London Road (Guildford)", Code: "LRD"},
+	{Name: "London St Pancras (Intl)", Code: "SPX"},
+	{Name: "London St Pancras International", Code: "STP", TIPLOC: "STPX", Lat: 51.5322, Lon: -0.1270, Country: England},
+	{Name: "London Victoria", Code: "VIC", TIPLOC: "VICTRIC", Lat: 51.4952, Lon: -0.1441, Country: England},
+	{Name: "London Waterloo", Code: "WAT", TIPLOC: "WATRLMN", Lat: 51.5031, Lon: -0.1132, Country: England},
+	{Name: "London Waterloo East", Code: "WAE"},
+	{Name: "Long Buckby", Code: "LBK"},
+	{Name: "Long Eaton", Code: "LGE"},
+	{Name: "Long Preston", Code: "LPR"},
+	{Name: "Longbeck", Code: "LGK"},
+	{Name: "Longbridge", Code: "LOB"},
+	{Name: "Longcross", Code: "LNG"},
+	{Name: "Longfield", Code: "LGF"},
+	{Name: "Longniddry", Code: "LND"},
+	{Name: "Longport", Code: "LPT"},
+	{Name: "Longton", Code: "LGN"},
+	{Name: "Looe", Code: "LOO"},
+	{Name: "Lostock", Code: "LOT"},
+	{Name: "Lostock Gralam", Code: "LTG"},
+	{Name: "Lostock Hall", Code: "LOH"},
+	{Name: "Lostwithiel", Code: "LOS"},
+	{Name: "Loughborough", Code: "LBO"},
+	{Name: "Loughborough Junction", Code: "LGJ"},
+	{Name: "Lowdham", Code: "LOW"},
+	{Name: "Lower Sydenham", Code: "LSY"},
+	{Name: "Lowestoft", Code: "LWT"},
+	{Name: "Ludlow", Code: "LUD"},
+	{Name: "Luton", Code: "LUT"},
+	{Name: "Luton Airport Parkway", Code: "LTN"},
+	{Name: "Luxulyan", Code: "LUX"},
+	{Name: "Lydney", Code: "LYD"},
+	{Name: "Lye (West Midlands)", Code: "LYE"},
+	{Name: "Lymington Pier", Code: "LYP"},
+	{Name: "Lymington Town", Code: "LYT"},
+	{Name: "Lympstone Commando", Code: "LYC"},
+	{Name: "Lympstone Village", Code: "LYM"},
+	{Name: "Lytham", Code: "LTM"},
+	{Name: "Macclesfield", Code: "MAC"},
+	{Name: "Machynlleth", Code: "MCN"},
+	{Name: "Maesteg", Code: "MST"},
+	{Name: "Maesteg (Ewenny Road)", Code: "MEW"},
+	{Name: "Maghull", Code: "MAG"},
+	{Name: "Maiden Newton", Code: "MDN"},
+	{Name: "Maidenhead", Code: "MAI"},
+	{Name: "Maidstone Barracks", Code: "MDB"},
+	{Name: "Maidstone East", Code: "MDE"},
+	{Name: "Maidstone West", Code: "MDW"},
+	{Name: "Malden Manor", Code: "MAL"},
+	{Name: "Mallaig", Code: "MLG"},
+	{Name: "Malton", Code: "MLT"},
+	{Name: "Malvern Link", Code: "MVL"},
+	{Name: "Manchester Airport", Code: "MIA"},
+	{Name: "Manchester Oxford Road", Code: "MCO"},
+	{Name: "Manchester Piccadilly", Code: "MAN", TIPLOC: "MNCRPIC", Lat: 53.4774, Lon: -2.2309, Country: England},
+	{Name: "Manchester United Football Ground", Code: "MUF"},
+	{Name: "Manchester Victoria", Code: "MCV", TIPLOC: "MNCRVIC", Lat: 53.4875, Lon: -2.2425, Country: England},
+	{Name: "Manea", Code: "MNE"},
+	{Name: "Manningtree", Code: "MNG"},
+	{Name: "Manor Park", Code: "MNP"},
+	{Name: "Manor Road", Code: "MNR"},
+	{Name: "Manorbier", Code: "MRB"},
+	{Name: "Manors", Code: "MAS"},
+	{Name: "Mansfield", Code: "MFT"},
+	{Name: "Mansfield Woodhouse", Code: "MSW"},
+	{Name: "March", Code: "MCH"},
+	{Name: "Marden (Kent)", Code: "MRN"},
+	{Name: "Margate", Code: "MAR"},
+	{Name: "Market Harborough", Code: "MHR"},
+	{Name: "Market Rasen", Code: "MKR"},
+	{Name: "Markinch", Code: "MNC"},
+	{Name: "Marks Tey", Code: "MKT"},
+	{Name: "Marlow", Code: "MLW"},
+	{Name: "Marple", Code: "MPL"},
+	{Name: "Marsden (Yorks)", Code: "MSN"},
+	{Name: "Marske", Code: "MSK"},
+	{Name: "Marston Green", Code: "MGN"},
+	{Name: "Martin Mill", Code: "MTM"},
+	{Name: "Martins Heron", Code: "MAO"},
+	{Name: "Marton", Code: "MTO"},
+	{Name: "Maryhill", Code: "MYH"},
+	{Name: "Maryland", Code: "MYL"},
+	{Name: "Maryport", Code: "MRY"},
+	{Name: "Matlock", Code: "MAT"},
+	{Name: "Matlock Bath", Code: "MTB"},
+	{Name: "Mauldeth Road", Code: "MAU"},
+	{Name: "Maxwell Park", Code: "MAX"},
+	{Name: "Maybole", Code: "MAY"},
+	{Name: "Maze Hill", Code: "MZH"},
+	{Name: "Meadowhall", Code: "MHS"},
+	{Name: "Meldreth", Code: "MEL"},
+	{Name: "Melksham", Code: "MKM"},
+	{Name: "Melton (Suffolk)", Code: "MES"},
+	{Name: "Melton Mowbray", Code: "MMO"},
+	{Name: "Menheniot", Code: "MEN"},
+	{Name: "Menston", Code: "MNN"},
+	{Name: "Meols", Code: "MEO"},
+	{Name: "Meols Cop", Code: "MEC"},
+	{Name: "Meopham", Code: "MEP"},
+	{Name: "Merryton", Code: "MEY"},
+	{Name: "Merstham", Code: "MHM"},
+	{Name: "Merthyr Tydfil", Code: "MER"},
+	{Name: "Merthyr Vale", Code: "MEV"},
+	{Name: "Metheringham", Code: "MGM"},
+	{Name: "MetroCentre", Code: "MCE"},
+	{Name: "Mexborough", Code: "MEX"},
+	{Name: "Micheldever", Code: "MIC"},
+	{Name: "Micklefield", Code: "MIK"},
+	{Name: "Middlesbrough", Code: "MBR"},
+	{Name: "Middlewood", Code: "MDL"},
+	{Name: "Midgham", Code: "MDG"},
+	{Name: "Milford (Surrey)", Code: "MLF"},
+	{Name: "Milford Haven", Code: "MFH"},
+	{Name: "Mill Hill (Lancs)", Code: "MLH"},
+	{Name: "Mill Hill Broadway", Code: "MIL"},
+	{Name: "Millbrook (Beds)", Code: "MLB"},
+	{Name: "Millbrook (Hants)", Code: "MBK"},
+	{Name: "Milliken Park", Code: "MIN"},
+	{Name: "Millom", Code: "MLM"},
+	{Name: "Mills Hill (Manchester)", Code: "MIH"},
+	{Name: "Milngavie", Code: "MLN"},
+	{Name: "Milton Keynes Central", Code: "MKC", TIPLOC: "MKNSCEN", Lat: 52.0342, Lon: -0.7740, Country: England},
+	{Name: "Minffordd", Code: "MFF"},
+	{Name: "Minster", Code: "MSR"},
+	{Name: "Mirfield", Code: "MIR"},
+	{Name: "Mistley", Code: "MIS"},
+	{Name: "Mitcham Eastfields", Code: "MTC"},
+	{Name: "Mitcham Junction", Code: "MIJ"},
+	{Name: "Mobberley", Code: "MOB"},
+	{Name: "Monifieth", Code: "MON"},
+	{Name: "Monks Risborough", Code: "MRS"},
+	{Name: "Montpelier", Code: "MTP"},
+	{Name: "Montrose", Code: "MTS"},
+	{Name: "Moorfields", Code: "MRF"},
+	{Name: "Moorgate", Code: "MOG"},
+	{Name: "Moorside", Code: "MSD"},
+	{Name: "Moorthorpe", Code: "MRP"},
+	{Name: "Morar", Code: "MRR"},
+	{Name: "Morchard Road", Code: "MRD"},
+	{Name: "Morden South", Code: "MDS"},
+	{Name: "Morecambe", Code: "MCM"},
+	{Name: "Moreton (Dorset)", Code: "MTN"},
+	{Name: "Moreton (Merseyside)", Code: "MRT"},
+	{Name: "Moreton-in-Marsh", Code: "MIM"},
+	{Name: "Morfa Mawddach", Code: "MFA"},
+	{Name: "Morley", Code: "MLY"},
+	{Name: "Morpeth", Code: "MPT"},
+	{Name: "Mortimer", Code: "MOR"},
+	{Name: "Mortlake", Code: "MTL"},
+	{Name: "Moses Gate", Code: "MSS"},
+	{Name: "Moss Side", Code: "MOS"},
+	{Name: "Mossley (Manchester)", Code: "MSL"},
+	{Name: "Mossley Hill", Code: "MSH"},
+	{Name: "Mosspark", Code: "MPK"},
+	{Name: "Moston", Code: "MSO"},
+	{Name: "Motherwell", Code: "MTH"},
+	{Name: "Motspur Park", Code: "MOT"},
+	{Name: "Mottingham", Code: "MTG"},
+	{Name: "Mottisfont & Dunbridge", Code: "DBG"},
+	{Name: "Mouldsworth", Code: "MLD"},
+	{Name: "Moulsecoomb", Code: "MCB"},
+	{Name: "Mount Florida", Code: "MFL"},
+	{Name: "Mount Vernon", Code: "MTV"},
+	{Name: "Mountain Ash", Code: "MTA"},
+	{Name: "Muir of Ord", Code: "MOO"},
+	{Name: "Muirend", Code: "MUI"},
+	{Name: "Musselburgh", Code: "MUB"},
+	{Name: "Mytholmroyd", Code: "MYT"},
+	{Name: "Nafferton", Code: "NFN"},
+	{Name: "Nailsea & Backwell", Code: "NLS"},
+	{Name: "Nairn", Code: "NRN"},
+	{Name: "Nantwich", Code: "NAN"},
+	{Name: "Narberth", Code: "NAR"},
+	{Name: "Narborough", Code: "NBR"},
+	{Name: "Navigation Road", Code: "NVR"},
+	{Name: "Neath", Code: "NTH"},
+	{Name: "Needham Market", Code: "NMT"},
+	{Name: "Neilston", Code: "NEI"},
+	{Name: "Nelson", Code: "NEL"},
+	{Name: "Neston", Code: "NES"},
+	{Name: "Netherfield", Code: "NET"},
+	{Name: "Nethertown", Code: "NRT"},
+	{Name: "Netley", Code: "NTL"},
+	{Name: "New Barnet", Code: "NBA"},
+	{Name: "New Beckenham", Code: "NBC"},
+	{Name: "New Brighton", Code: "NBN"},
+	{Name: "New Clee", Code: "NCE"},
+	{Name: "New Cross", Code: "NWX"},
+	{Name: "New Cross Gate", Code: "NXG"},
+	{Name: "New Cumnock", Code: "NCK"},
+	{Name: "New Eltham", Code: "NEH"},
+	{Name: "New Holland", Code: "NHL"},
+	{Name: "New Hythe", Code: "NHE"},
+	{Name: "New Lane", Code: "NLN"},
+	{Name: "New Malden", Code: "NEM"},
+	{Name: "New Mills Central", Code: "NMC"},
+	{Name: "New Mills Newtown", Code: "NMN"},
+	{Name: "New Milton", Code: "NWM"},
+	{Name: "New Pudsey", Code: "NPD"},
+	{Name: "New Southgate", Code: "NSG"},
+	{Name: "Newark Castle", Code: "NCT"},
+	{Name: "Newark North Gate", Code: "NNG"},
+	{Name: "Newbridge", Code: "NBE"},
+	{Name: "Newbury", Code: "NBY"},
+	{Name: "Newbury Racecourse", Code: "NRC"},
+	{Name: "Newcastle", Code: "NCL", TIPLOC: "NWCSTLE", Lat: 54.9683, Lon: -1.6173, Country: England},
+	{Name: "Newcourt", Code: "NCO"},
+	{Name: "Newcraighall", Code: "NEW"},
+	{Name: "Newhaven Harbour", Code: "NVH"},
+	{Name: "Newhaven Town", Code: "NVN"},
+	{Name: "Newington", Code: "NGT"},
+	{Name: "Newmarket", Code: "NMK"},
+	{Name: "Newport (Essex)", Code: "NWE"},
+	{Name: "Newport (South Wales)", Code: "NWP", TIPLOC: "NWPTRTG", Lat: 51.5886, Lon: -2.9990, Country: Wales},
+	{Name: "Newquay", Code: "NQY"},
+	{Name: "Newstead", Code: "NSD"},
+	{Name: "Newton (Lanark)", Code: "NTN"},
+	{Name: "Newton Abbot", Code: "NTA"},
+	{Name: "Newton Aycliffe", Code: "NAY"},
+	{Name: "Newton for Hyde", Code: "NWN"},
+	{Name: "Newton St Cyres", Code: "NTC"},
+	{Name: "Newtongrange", Code: "NEG"},
+	{Name: "Newton-le-Willows", Code: "NLW"},
+	{Name: "Newtonmore", Code: "NWR"},
+	{Name: "Newton-on-Ayr", Code: "NOA"},
+	{Name: "Newtown (Powys)", Code: "NWT"},
+	{Name: "Ninian Park", Code: "NNP"},
+	{Name: "Nitshill", Code: "NIT"},
+	{Name: "Norbiton", Code: "NBT"},
+	{Name: "Norbury", Code: "NRB"},
+	{Name: "Normans Bay", Code: "NSB"},
+	{Name: "Normanton", Code: "NOR"},
+	{Name: "North Berwick", Code: "NBW"},
+	{Name: "North Camp", Code: "NCM"},
+	{Name: "North Dulwich", Code: "NDL"},
+	{Name: "North Fambridge", Code: "NFA"},
+	{Name: "North Llanrwst", Code: "NLR"},
+	{Name: "North Queensferry", Code: "NQU"},
+	{Name: "North Road (Darlington)", Code: "NRD"},
+	{Name: "North Sheen", Code: "NSH"},
+	{Name: "North Walsham", Code: "NWA"},
+	{Name: "North Wembley", Code: "NWB"},
+	{Name: "Northallerton", Code: "NTR"},
+	{Name: "Northampton", Code: "NMP"},
+	{Name: "Northfield", Code: "NFD"},
+	{Name: "Northfleet", Code: "NFL"},
+	{Name: "Northolt Park", Code: "NLT"},
+	{Name: "Northumberland Park", Code: "NUM"},
+	{Name: "Northwich", Code: "NWI"},
+	{Name: "Norwich", Code: "NRW", TIPLOC: "NRCH", Lat: 52.6269, Lon: 1.3067, Country: England},
+	{Name: "Norwood Junction", Code: "NWD"},
+	{Name: "Nottingham", Code: "NOT", TIPLOC: "NTNG", Lat: 52.9470, Lon: -1.1462, Country: England},
+	{Name: "Nuneaton", Code: "NUN"},
+	{Name: "Nunhead", Code: "NHD"},
+	{Name: "Nunthorpe", Code: "NNT"},
+	{Name: "Nutbourne", Code: "NUT"},
+	{Name: "Nutfield", Code: "NUF"},
+	{Name: "Oakengates", Code: "OKN"},
+	{Name: "Oakham", Code: "OKM"},
+	{Name: "Oakleigh Park", Code: "OKL"},
+	{Name: "Oban", Code: "OBN"},
+	{Name: "Ockendon", Code: "OCK"},
+	{Name: "Ockley", Code: "OLY"},
+	{Name: "Okehampton", Code: "OKE"},
+	{Name: "Old Hill", Code: "OHL"},
+	{Name: "Old Roan", Code: "ORN"},
+	{Name: "Old Street", Code: "OLD"},
+	{Name: "Oldfield Park", Code: "OLF"},
+	{Name: "Olton", Code: "OLT"},
+	{Name: "Ore", Code: "ORE"},
+	{Name: "Ormskirk", Code: "OMS"},
+	{Name: "Orpington", Code: "ORP"},
+	{Name: "Orrell", Code: "ORR"},
+	{Name: "Orrell Park", Code: "OPK"},
+	{Name: "Otford", Code: "OTF"},
+	{Name: "Oulton Broad North", Code: "OUN"},
+	{Name: "Oulton Broad South", Code: "OUS"},
+	{Name: "Outwood", Code: "OUT"},
+	{Name: "Overpool", Code: "OVE"},
+	{Name: "Overton", Code: "OVR"},
+	{Name: "Oxenholme Lake District", Code: "OXN"},
+	{Name: "Oxford", Code: "OXF", TIPLOC: "OXFD", Lat: 51.7535, Lon: -1.2700, Country: England},
+	{Name: "Oxford Parkway", Code: "OXP"},
+	{Name: "Oxshott", Code: "OXS"},
+	{Name: "Oxted", Code: "OXT"},
+	{Name: "Paddock Wood", Code: "PDW"},
+	{Name: "Padgate", Code: "PDG"},
+	{Name: "Paignton", Code: "PGN"},
+	{Name: "Paisley Canal", Code: "PCN"},
+	{Name: "Paisley Gilmour Street", Code: "PYG"},
+	{Name: "Paisley St James", Code: "PYJ"},
+	{Name: "Palmers Green", Code: "PAL"},
+	{Name: "Pangbourne", Code: "PAN"},
+	{Name: "Pannal", Code: "PNL"},
+	{Name: "Pantyffynnon", Code: "PTF"},
+	{Name: "Par", Code: "PAR"},
+	{Name: "Parbold", Code: "PBL"},
+	{Name: "Park Street", Code: "PKT"},
+	{Name: "Parkstone (Dorset)", Code: "PKS"},
+	{Name: "Parson Street", Code: "PSN"},
+	{Name: "Partick", Code: "PTK"},
+	{Name: "Parton", Code: "PRN"},
+	{Name: "Patchway", Code: "PWY"},
+	{Name: "Patricroft", Code: "PAT"},
+	{Name: "Patterton", Code: "PTT"},
+	{Name: "Peartree", Code: "PEA"},
+	{Name: "Peckham Rye", Code: "PMR"},
+	{Name: "Pegswood", Code: "PEG"},
+	{Name: "Pemberton", Code: "PEM"},
+	{Name: "Pembrey & Burry Port", Code: "PBY"},
+	{Name: "Pembroke", Code: "PMB"},
+	{Name: "Pembroke Dock", Code: "PMD"},
+	{Name: "Penally", Code: "PNA"},
+	{Name: "Penarth", Code: "PEN"},
+	{Name: "Pencoed", Code: "PCD"},
+	{Name: "Pengam", Code: "PGM"},
+	{Name: "Penge East", Code: "PNE"},
+	{Name: "Penge West", Code: "PNW"},
+	{Name: "Penhelig", Code: "PHG"},
+	{Name: "Penistone", Code: "PNS"},
+	{Name: "Penkridge", Code: "PKG"},
+	{Name: "Penmaenmawr", Code: "PMW"},
+	{Name: "Penmere", Code: "PNM"},
+	{Name: "Penrhiwceiber", Code: "PER"},
+	{Name: "Penrhyndeudraeth", Code: "PRH"},
+	{Name: "Penrith (North Lakes)", Code: "PNR"},
+	{Name: "Penryn (Cornwall)", Code: "PYN"},
+	{Name: "Pensarn (Gwynedd)", Code: "PES"},
+	{Name: "Penshurst", Code: "PHR"},
+	{Name: "Pentre-Bach", Code: "PTB"},
+	{Name: "Pen-y-Bont", Code: "PNY"},
+	{Name: "Penychain", Code: "PNC"},
+	{Name: "Penyffordd", Code: "PNF"},
+	{Name: "Penzance", Code: "PNZ"},
+	{Name: "Perranwell", Code: "PRW"},
+	{Name: "Perry Barr", Code: "PRY"},
+	{Name: "Pershore", Code: "PSH"},
+	{Name: "Perth", Code: "PTH", TIPLOC: "PERTH", Lat: 56.3920, Lon: -3.4387, Country: Scotland},
+	{Name: "Peterborough", Code: "PBO", TIPLOC: "PBRO", Lat: 52.5748, Lon: -0.2500, Country: England},
+	{Name: "Petersfield", Code: "PTR"},
+	{Name: "Petts Wood", Code: "PET"},
+	{Name: "Pevensey & Westham", Code: "PEV"},
+	{Name: "Pevensey Bay", Code: "PEB"},
+	{Name: "Pewsey", Code: "PEW"},
+	{Name: "Pilning", Code: "PIL"},
+	{Name: "Pinhoe", Code: "PIN"},
+	{Name: "Pitlochry", Code: "PIT"},
+	{Name: "Pitsea", Code: "PSE"},
+	{Name: "Pleasington", Code: "PLS"},
+	{Name: "Plockton", Code: "PLK"},
+	{Name: "Pluckley", Code: "PLC"},
+	{Name: "Plumley", Code: "PLM"},
+	{Name: "Plumpton", Code: "PMP"},
+	{Name: "Plumstead", Code: "PLU"},
+	{Name: "Plymouth", Code: "PLY", TIPLOC: "PLYMTH", Lat: 50.3778, Lon: -4.1434, Country: England},
+	{Name: "Pokesdown", Code: "POK"},
+	{Name: "Polegate", Code: "PLG"},
+	{Name: "Polesworth", Code: "PSW"},
+	{Name: "Pollokshaws East", Code: "PWE"},
+	{Name: "Pollokshaws West", Code: "PWW"},
+	{Name: "Pollokshields East", Code: "PLE"},
+	{Name: "Pollokshields West", Code: "PLW"},
+	{Name: "Polmont", Code: "PMT"},
+	{Name: "Polsloe Bridge", Code: "POL"},
+	{Name: "Ponders End", Code: "PON"},
+	{Name: "Pontarddulais", Code: "PTD"},
+	{Name: "Pontefract Baghill", Code: "PFR"},
+	{Name: "Pontefract Monkhill", Code: "PFM"},
+	{Name: "Pontefract Tanshelf", Code: "POT"},
+	{Name: "Pontlottyn", Code: "PLT"},
+	{Name: "Pontyclun", Code: "PYC"},
+	{Name: "Pont-y-Pant", Code: "PYP"},
+	{Name: "Pontypool & New Inn", Code: "PPL"},
+	{Name: "Pontypridd", Code: "PPD"},
+	{Name: "Poole", Code: "POO"},
+	{Name: "Poppleton", Code: "POP"},
+	{Name: "Port Glasgow", Code: "PTG"},
+	{Name: "Port Sunlight", Code: "PSL"},
+	{Name: "Port Talbot Parkway", Code: "PTA"},
+	{Name: "Portchester", Code: "PTC"},
+	{Name: "Porth", Code: "POR"},
+	{Name: "Porthmadog", Code: "PTM"},
+	{Name: "Portlethen", Code: "PLN"},
+	{Name: "Portslade", Code: "PLD"},
+	{Name: "Portsmouth & Southsea", Code: "PMS"},
+	{Name: "Portsmouth Arms", Code: "PMA"},
+	{Name: "Portsmouth Harbour", Code: "PMH"},
+	{Name: "Possilpark & Parkhouse", Code: "PPK"},
+	{Name: "Potters Bar", Code: "PBR"},
+	{Name: "Poulton-le-Fylde", Code: "PFY"},
+	{Name: "Poynton", Code: "PYT"},
+	{Name: "Prees", Code: "PRS"},
+	{Name: "Prescot", Code: "PSC"},
+	{Name: "Prestatyn", Code: "PRT"},
+	{Name: "Prestbury", Code: "PRB"},
+	{Name: "Preston (Lancs)", Code: "PRE", TIPLOC: "PRST", Lat: 53.7564, Lon: -2.7080, Country: England},
+	{Name: "Preston Park", Code: "PRP"},
+	{Name: "Prestonpans", Code: "PST"},
+	{Name: "Prestwick International Airport", Code: "PRA"},
+	{Name: "Prestwick Town", Code: "PTW"},
+	{Name: "Priesthill & Darnley", Code: "PTL"},
+	{Name: "Princes Risborough", Code: "PRR"},
+	{Name: "Prittlewell", Code: "PRL"},
+	{Name: "Prudhoe", Code: "PRU"},
+	{Name: "Pulborough", Code: "PUL"},
+	{Name: "Purfleet", Code: "PFL"},
+	{Name: "Purley", Code: "PUR"},
+	{Name: "Purley Oaks", Code: "PUO"},
+	{Name: "Putney", Code: "PUT"},
+	{Name: "Pwllheli", Code: "PWL"},
+	{Name: "Pye Corner", Code: "PYE"},
+	{Name: "Pyle", Code: "PYL"},
+	{Name: "Quakers Yard", Code: "QYD"},
+	{Name: "Queenborough", Code: "QBR"},
+	{Name: "Queens Park (Glasgow)", Code: "QPK"},
+	{Name: "Queens Park (London)", Code: "QPW"},
+	{Name: "Queens Road (Peckham)", Code: "QRP"},
+	{Name: "Queenstown Road (Battersea)", Code: "QRB"},
+	{Name: "Quintrell Downs", Code: "QUI"},
+	{Name: "Radcliffe-on-Trent", Code: "RDF"},
+	{Name: "Radlett", Code: "RDT"},
+	{Name: "Radley", Code: "RAD"},
+	{Name: "Radyr", Code: "RDR"},
+	{Name: "Rainford", Code: "RNF"},
+	{Name: "Rainham (Essex)", Code: "RNM"},
+	{Name: "Rainham (Kent)", Code: "RAI"},
+	{Name: "Rainhill", Code: "RNH"},
+	{Name: "Ramsgate", Code: "RAM"},
+	{Name: "Ramsgreave & Wilpshire", Code: "RGW"},
+	{Name: "Rannoch", Code: "RAN"},
+	{Name: "Rauceby", Code: "RAU"},
+	{Name: "Ravenglass for Eskdale", Code: "RAV"},
+	{Name: "Ravensbourne", Code: "RVB"},
+	{Name: "Ravensthorpe", Code: "RVN"},
+	{Name: "Rawcliffe", Code: "RWC"},
+	{Name: "Rayleigh", Code: "RLG"},
+	{Name: "Raynes Park", Code: "RAY"},
+	{Name: "Reading", Code: "RDG", TIPLOC: "RDNGSTN", Lat: 51.4588, Lon: -0.9718, Country: England},
+	{Name: "Reading West", Code: "RDW"},
+	{Name: "Rectory Road", Code: "REC"},
+	{Name: "Redbridge", Code: "RDB"},
+	{Name: "Redcar British Steel", Code: "RBS"},
+	{Name: "Redcar Central", Code: "RCC"},
+	{Name: "Redcar East", Code: "RCE"},
+	{Name: "Reddish North", Code: "RDN"},
+	{Name: "Reddish South", Code: "RDS"},
+	{Name: "Redditch", Code: "RDC"},
+	{Name: "Redhill", Code: "RDH"},
+	{Name: "Redland", Code: "RDA"},
+	{Name: "Redruth", Code: "RED"},
+	{Name: "Reedham (Norfolk)", Code: "REE"},
+	{Name: "Reedham (Surrey)", Code: "RHM"},
+	{Name: "Reigate", Code: "REI"},
+	{Name: "Renton", Code: "RTN"},
+	{Name: "Retford", Code: "RET"},
+	{Name: "Rhiwbina", Code: "RHI"},
+	{Name: "Rhoose Cardiff International Airport", Code: "RIA"},
+	{Name: "Rhosneigr", Code: "RHO"},
+	{Name: "Rhyl", Code: "RHL"},
+	{Name: "Rhymney", Code: "RHY"},
+	{Name: "Ribblehead", Code: "RHD"},
+	{Name: "Rice Lane", Code: "RIL"},
+	{Name: "Richmond (London)", Code: "RMD"},
+	{Name: "Rickmansworth", Code: "RIC"},
+	{Name: "Riddlesdown", Code: "RDD"},
+	{Name: "Ridgmont", Code: "RID"},
+	{Name: "Riding Mill", Code: "RDM"},
+	{Name: "Risca & Pontymister", Code: "RCA"},
+	{Name: "Rishton", Code: "RIS"},
+	{Name: "Robertsbridge", Code: "RBR"},
+	{Name: "Roby", Code: "ROB"},
+	{Name: "Rochdale", Code: "RCD"},
+	{Name: "Roche", Code: "ROC"},
+	{Name: "Rochester", Code: "RTR"},
+	{Name: "Rochford", Code: "RFD"},
+	{Name: "Rock Ferry", Code: "RFY"},
+	{Name: "Rogart", Code: "ROG"},
+	{Name: "Rogerstone", Code: "ROR"},
+	{Name: "Rolleston", Code: "ROL"},
+	{Name: "Roman Bridge", Code: "RMB"},
+	{Name: "Romford", Code: "RMF"},
+	{Name: "Romiley", Code: "RML"},
+	{Name: "Romsey", Code: "ROM"},
+	{Name: "Roose", Code: "ROO"},
+	{Name: "Rose Grove", Code: "RSG"},
+	{Name: "Rose Hill Marple", Code: "RSH"},
+	{Name: "Rosyth", Code: "ROS"},
+	{Name: "Rotherham Central", Code: "RMC"},
+	{Name: "Rotherhithe", Code: "ROE"},
+	{Name: "Roughton Road", Code: "RNR"},
+	{Name: "Rowlands Castle", Code: "RLN"},
+	{Name: "Rowley Regis", Code: "ROW"},
+	{Name: "Roy Bridge", Code: "RYB"},
+	{Name: "Roydon", Code: "RYN"},
+	{Name: "Royston", Code: "RYS"},
+	{Name: "Ruabon", Code: "RUA"},
+	{Name: "Rufford", Code: "RUF"},
+	{Name: "Rugby", Code: "RUG"},
+	{Name: "Rugeley Town", Code: "RGT"},
+	{Name: "Rugeley Trent Valley", Code: "RGL"},
+	{Name: "Runcorn", Code: "RUN"},
+	{Name: "Runcorn East", Code: "RUE"},
+	{Name: "Ruskington", Code: "RKT"},
+	{Name: "Ruswarp", Code: "RUS"},
+	{Name: "Rutherglen", Code: "RUT"},
+	{Name: "Ryde Esplanade", Code: "RYD"},
+	{Name: "Ryde Pier Head", Code: "RYP"},
+	{Name: "Ryde St Johns Road", Code: "RYR"},
+	{Name: "Ryder Brow", Code: "RRB"},
+	{Name: "Rye (Sussex)", Code: "RYE"},
+	{Name: "Rye House", Code: "RYH"},
+	{Name: "Salford Central", Code: "SFD"},
+	{Name: "Salford Crescent", Code: "SLD"},
+	{Name: "Salfords (Surrey)", Code: "SAF"},
+	{Name: "Salhouse", Code: "SAH"},
+	{Name: "Salisbury", Code: "SAL"},
+	{Name: "Saltaire", Code: "SAE"},
+	{Name: "Saltash", Code: "STS"},
+	{Name: "Saltburn", Code: "SLB"},
+	{Name: "Saltcoats", Code: "SLT"},
+	{Name: "Saltmarshe", Code: "SAM"},
+	{Name: "Salwick", Code: "SLW"},
+	{Name: "Sampford Courtenay", Code: "SMC"},
+	{Name: "Sandal & Agbrigg", Code: "SNA"},
+	{Name: "Sandbach", Code: "SDB"},
+	{Name: "Sanderstead", Code: "SNR"},
+	{Name: "Sandhills", Code: "SDL"},
+	{Name: "Sandhurst (Berks)", Code: "SND"},
+	{Name: "Sandling", Code: "SDG"},
+	{Name: "Sandown", Code: "SAN"},
+	{Name: "Sandplace", Code: "SDP"},
+	{Name: "Sandwell & Dudley", Code: "SAD"},
+	{Name: "Sandwich", Code: "SDW"},
+	{Name: "Sandy", Code: "SDY"},
+	{Name: "Sankey for Penketh", Code: "SNK"},
+	{Name: "Sanquhar", Code: "SQH"},
+	{Name: "Sarn", Code: "SRR"},
+	{Name: "Saundersfoot", Code: "SDF"},
+	{Name: "Saunderton", Code: "SDR"},
+	{Name: "Sawbridgeworth", Code: "SAW"},
+	{Name: "Saxilby", Code: "SXY"},
+	{Name: "Saxmundham", Code: "SAX"},
+	{Name: "Scarborough", Code: "SCA"},
+	{Name: "Scotscalder", Code: "SCT"},
+	{Name: "Scotstounhill", Code: "SCH"},
+	{Name: "Scunthorpe", Code: "SCU"},
+	{Name: "Sea Mills", Code: "SML"},
+	{Name: "Seaford (Sussex)", Code: "SEF"},
+	{Name: "Seaforth & Litherland", Code: "SFL"},
+	{Name: "Seaham", Code: "SEA"},
+	{Name: "Seamer", Code: "SEM"},
+	{Name: "Seascale", Code: "SSC"},
+	{Name: "Seaton Carew", Code: "SEC"},
+	{Name: "Seer Green & Jordans", Code: "SRG"},
+	{Name: "Selby", Code: "SBY"},
+	{Name: "Selhurst", Code: "SRS"},
+	{Name: "Sellafield", Code: "SEL"},
+	{Name: "Selling", Code: "SEG"},
+	{Name: "Selly Oak", Code: "SLY"},
+	{Name: "Settle", Code: "SET"},
+	{Name: "Seven Kings", Code: "SVK"},
+	{Name: "Seven Sisters", Code: "SVS"},
+	{Name: "Sevenoaks", Code: "SEV"},
+	{Name: "Severn Beach", Code: "SVB"},
+	{Name: "Severn Tunnel Junction", Code: "STJ"},
+	{Name: "Shadwell", Code: "SDE"},
+	{Name: "Shalford (Surrey)", Code: "SFR"},
+	{Name: "Shanklin", Code: "SHN"},
+	{Name: "Shawfair", Code: "SFI"},
+	{Name: "Shawford", Code: "SHW"},
+	{Name: "Shawlands", Code: "SHL"},
+	{Name: "Sheerness-on-Sea", Code: "SSS"},
+	{Name: "Sheffield", Code: "SHF", TIPLOC: "SHEFFLD", Lat: 53.3781, Lon: -1.4623, Country: England},
+	{Name: "Shelford (Cambs)", Code: "SED"},
+	{Name: "Shenfield", Code: "SNF"},
+	{Name: "Shenstone", Code: "SEN"},
+	{Name: "Shepherd's Bush", Code: "SPB"},
+	{Name: "Shepherds Well", Code: "SPH"},
+	{Name: "Shepley", Code: "SPY"},
+	{Name: "Shepperton", Code: "SHP"},
+	{Name: "Shepreth", Code: "STH"},
+	{Name: "Sherborne", Code: "SHE"},
+	{Name: "Sherburn-in-Elmet", Code: "SIE"},
+	{Name: "Sheringham", Code: "SHM"},
+	{Name: "Shettleston", Code: "SLS"},
+	{Name: "Shieldmuir", Code: "SDM"},
+	{Name: "Shifnal", Code: "SFN"},
+	{Name: "Shildon", Code: "SHD"},
+	{Name: "Shiplake", Code: "SHI"},
+	{Name: "Shipley (Yorks)", Code: "SHY"},
+	{Name: "Shippea Hill", Code: "SPP"},
+	{Name: "Shipton", Code: "SIP"},
+	{Name: "Shirebrook", Code: "SHB"},
+	{Name: "Shirehampton", Code: "SHH"},
+	{Name: "Shireoaks", Code: "SRO"},
+	{Name: "Shirley", Code: "SRL"},
+	{Name: "Shoeburyness", Code: "SRY"},
+	{Name: "Sholing", Code: "SHO"},
+	{Name: "Shoreditch High Street", Code: "SDC"},
+	{Name: "Shoreham (Kent)", Code: "SEH"},
+	{Name: "Shoreham-by-Sea", Code: "SSE"},
+	{Name: "Shortlands", Code: "SRT"},
+	{Name: "Shotton", Code: "SHT"},
+	{Name: "Shotts", Code: "SHS"},
+	{Name: "Shrewsbury", Code: "SHR"},
+	{Name: "Sidcup", Code: "SID"},
+	{Name: "Sileby", Code: "SIL"},
+	{Name: "Silecroft", Code: "SIC"},
+	{Name: "Silkstone Common", Code: "SLK"},
+	{Name: "Silver Street", Code: "SLV"},
+	{Name: "Silverdale", Code: "SVR"},
+	{Name: "Singer", Code: "SIN"},
+	{Name: "Sittingbourne", Code: "SIT"},
+	{Name: "Skegness", Code: "SKG"},
+	{Name: "Skewen", Code: "SKE"},
+	{Name: "Skipton", Code: "SKI"},
+	{Name: "Slade Green", Code: "SGR"},
+	{Name: "Slaithwaite", Code: "SWT"},
+	{Name: "Slateford", Code: "SLA"},
+	{Name: "Sleaford", Code: "SLR"},
+	{Name: "Sleights", Code: "SLH"},
+	{Name: "Slough", Code: "SLO"},
+	{Name: "Small Heath", Code: "SMA"},
+	{Name: "Smallbrook Junction", Code: "SAB"},
+	{Name: "Smethwick Galton Bridge", Code: "SGB"},
+	{Name: "Smethwick Rolfe Street", Code: "SMR"},
+	{Name: "Smithy Bridge", Code: "SMB"},
+	{Name: "Snaith", Code: "SNI"},
+	{Name: "Snodland", Code: "SDA"},
+	{Name: "Snowdown", Code: "SWO"},
+	{Name: "Sole Street", Code: "SOR"},
+	{Name: "Solihull", Code: "SOL"},
+	{Name: "Somerleyton", Code: "SYT"},
+	{Name: "South Acton", Code: "SAT"},
+	{Name: "South Bank", Code: "SBK"},
+	{Name: "South Bermondsey", Code: "SBM"},
+	{Name: "South Croydon", Code: "SCY"},
+	{Name: "South Elmsall", Code: "SES"},
+	{Name: "South Greenford", Code: "SGN"},
+	{Name: "South Gyle", Code: "SGL"},
+	{Name: "South Hampstead", Code: "SOH"},
+	{Name: "South Kenton", Code: "SOK"},
+	{Name: "South Merton", Code: "SMO"},
+	{Name: "South Milford", Code: "SOM"},
+	{Name: "South Ruislip", Code: "SRU"},
+	{Name: "South Tottenham", Code: "STO"},
+	{Name: "South Wigston", Code: "SWS"},
+	{Name: "South Woodham Ferrers", Code: "SOF"},
+	{Name: "Southall", Code: "STL"},
+	{Name: "Southampton Airport Parkway", Code: "SOA"},
+	{Name: "Southampton Central", Code: "SOU", TIPLOC: "SOTON", Lat: 50.9075, Lon: -1.4136, Country: England},
+	{Name: "Southbourne", Code: "SOB"},
+	{Name: "Southbury", Code: "SBU"},
+	{Name: "Southease", Code: "SEE"},
+	{Name: "Southend Airport", Code: "SIA"},
+	{Name: "Southend Central", Code: "SOC"},
+	{Name: "Southend East", Code: "SOE"},
+	{Name: "Southend Victoria", Code: "SOV"},
+	{Name: "Southminster", Code: "SMN"},
+	{Name: "Southport", Code: "SOP"},
+	{Name: "Southwick", Code: "SWK"},
+	{Name: "Sowerby Bridge", Code: "SOW"},
+	{Name: "Spalding", Code: "SPA"},
+	{Name: "Spean Bridge", Code: "SBR"},
+	{Name: "Spital", Code: "SPI"},
+	{Name: "Spondon", Code: "SPO"},
+	{Name: "Spooner Row", Code: "SPN"},
+	{Name: "Spring Road", Code: "SRI"},
+	{Name: "Springburn", Code: "SPR"},
+	{Name: "Springfield", Code: "SPF"},
+	{Name: "Squires Gate", Code: "SQU"},
+	{Name: "St Albans Abbey", Code: "SAA"},
+	{Name: "St Albans City", Code: "SAC"},
+	{Name: "St Andrews Road", Code: "SAR"},
+	{Name: "St Annes-on-Sea", Code: "SAS"},
+	{Name: "St Austell", Code: "SAU"},
+	{Name: "St Bees", Code: "SBS"},
+	{Name: "St Budeaux Ferry Road", Code: "SBF"},
+	{Name: "St Budeaux Victoria Road", Code: "SBV"},
+	{Name: "St Columb Road", Code: "SCR"},
+	{Name: "St Denys", Code: "SDN"},
+	{Name: "St Erth", Code: "SER"},
+	{Name: "St Germans", Code: "SGM"},
+	{Name: "St Helens Central", Code: "SNH"},
+	{Name: "St Helens Junction", Code: "SHJ"},
+	{Name: "St Helier (Surrey)", Code: "SIH"},
+	{Name: "St Ives (Cornwall)", Code: "SIV"},
+	{Name: "St James Park (Exeter)", Code: "SJP"},
+	{Name: "St James Street (Walthamstow)", Code: "SJS"},
+	{Name: "St Johns (London)", Code: "SAJ"},
+	{Name: "St Keyne Wishing Well Halt", Code: "SKN"},
+	{Name: "St Leonards Warrior Square", Code: "SLQ"},
+	{Name: "St Margarets (Herts)", Code: "SMT"},
+	{Name: "St Margarets (London)", Code: "SMG"},
+	{Name: "St Mary Cray", Code: "SMY"},
+	{Name: "St Michaels", Code: "STM"},
+	{Name: "St Neots", Code: "SNO"},
+	{Name: "Stafford", Code: "STA", TIPLOC: "STAFFRD", Lat: 52.8036, Lon: -2.1218, Country: England},
+	{Name: "Staines", Code: "SNS"},
+	{Name: "Stallingborough", Code: "SLL"},
+	{Name: "Stalybridge", Code: "SYB"},
+	{Name: "Stamford (Lincs)", Code: "SMD"},
+	{Name: "Stamford Hill", Code: "SMH"},
+	{Name: "Stanford-le-Hope", Code: "SFO"},
+	{Name: "Stanlow & Thornton", Code: "SNT"},
+	{Name: "Stansted Airport", Code: "SSD"},
+	{Name: "Stansted Mountfitchet", Code: "SST"},
+	{Name: "Staplehurst", Code: "SPU"},
+	{Name: "Stapleton Road", Code: "SRD"},
+	{Name: "Starbeck", Code: "SBE"},
+	{Name: "Starcross", Code: "SCS"},
+	{Name: "Staveley (Cumbria)", Code: "SVL"},
+	{Name: "Stechford", Code: "SCF"},
+	{Name: "Steeton & Silsden", Code: "SON"},
+	{Name: "Stepps", Code: "SPS"},
+	{Name: "Stevenage", Code: "SVG"},
+	{Name: "Stevenston", Code: "STV"},
+	{Name: "Stewartby", Code: "SWR"},
+	{Name: "Stewarton", Code: "STT"},
+	{Name: "Stirling", Code: "STG", TIPLOC: "STIRLNG", Lat: 56.1199, Lon: -3.9358, Country: Scotland},
+	{Name: "Stockport", Code: "SPT"},
+	{Name: "Stocksfield", Code: "SKS"},
+	{Name: "Stocksmoor", Code: "SSM"},
+	{Name: "Stockton", Code: "STK"},
+	{Name: "Stoke Mandeville", Code: "SKM"},
+	{Name: "Stoke Newington", Code: "SKW"},
+	{Name: "Stoke-on-Trent", Code: "SOT"},
+	{Name: "Stone (Staffs)", Code: "SNE"},
+	{Name: "Stone Crossing", Code: "SCG"},
+	{Name: "Stonebridge Park", Code: "SBP"},
+	{Name: "Stonegate", Code: "SOG"},
+	{Name: "Stonehaven", Code: "STN"},
+	{Name: "Stonehouse", Code: "SHU"},
+	{Name: "Stoneleigh", Code: "SNL"},
+	{Name: "Stourbridge Junction", Code: "SBJ"},
+	{Name: "Stourbridge Town", Code: "SBT"},
+	{Name: "Stow", Code: "SOI"},
+	{Name: "Stowmarket", Code: "SMK"},
+	{Name: "Stranraer", Code: "STR"},
+	{Name: "Stratford (London)", Code: "SRA"},
+	{Name: "Stratford International", Code: "SFA"},
+	{Name: "Stratford-upon-Avon", Code: "SAV"},
+	{Name: "Stratford-upon-Avon Parkway", Code: "STY"},
+	{Name: "Strathcarron", Code: "STC"},
+	{Name: "Strawberry Hill", Code: "STW"},
+	{Name: "Streatham (Greater London)", Code: "STE"},
+	{Name: "Streatham Common", Code: "SRC"},
+	{Name: "Streatham Hill", Code: "SRH"},
+	{Name: "Streethouse", Code: "SHC"},
+	{Name: "Strines", Code: "SRN"},
+	{Name: "Stromeferry", Code: "STF"},
+	{Name: "Strood (Kent)", Code: "SOO"},
+	{Name: "Stroud (Gloucs)", Code: "STD"},
+	{Name: "Sturry", Code: "STU"},
+	{Name: "Styal", Code: "SYA"},
+	{Name: "Sudbury & Harrow Road", Code: "SUD"},
+	{Name: "Sudbury (Suffolk)", Code: "SUY"},
+	{Name: "Sudbury Hill Harrow", Code: "SDH"},
+	{Name: "Sugar Loaf", Code: "SUG"},
+	{Name: "Summerston", Code: "SUM"},
+	{Name: "Sunbury", Code: "SUU"},
+	{Name: "Sunderland", Code: "SUN"},
+	{Name: "Sundridge Park", Code: "SUP"},
+	{Name: "Sunningdale", Code: "SNG"},
+	{Name: "Sunnymeads", Code: "SNY"},
+	{Name: "Surbiton", Code: "SUR"},
+	{Name: "Surrey Quays", Code: "SQE"},
+	{Name: "Sutton (Surrey)", Code: "SUO"},
+	{Name: "Sutton Coldfield", Code: "SUT"},
+	{Name: "Sutton Common", Code: "SUC"},
+	{Name: "Sutton Parkway", Code: "SPK"},
+	{Name: "Swale", Code: "SWL"},
+	{Name: "Swanley", Code: "SAY"},
+	{Name: "Swanscombe", Code: "SWM"},
+	{Name: "Swansea", Code: "SWA", TIPLOC: "SWANSEA", Lat: 51.6252, Lon: -3.9415, Country: Wales},
+	{Name: "Swanwick", Code: "SNW"},
+	{Name: "Sway", Code: "SWY"},
+	{Name: "Swaythling", Code: "SWG"},
+	{Name: "Swinderby", Code: "SWD"},
+	{Name: "Swindon (Wilts)", Code: "SWI", TIPLOC: "SWINDON", Lat: 51.5655, Lon: -1.7855, Country: England},
+	{Name: "Swineshead", Code: "SWE"},
+	{Name: "Swinton (Manchester)", Code: "SNN"},
+	{Name: "Swinton (South Yorks)", Code: "SWN"},
+	{Name: "Sydenham (London)", Code: "SYD"},
+	{Name: "Sydenham Hill", Code: "SYH"},
+	{Name: "Syon Lane", Code: "SYL"},
+	{Name: "Syston", Code: "SYS"},
+	{Name: "Tackley", Code: "TAC"},
+	{Name: "Tadworth", Code: "TAD"},
+	{Name: "Taffs Well", Code: "TAF"},
+	{Name: "Tain", Code: "TAI"},
+	{Name: "Talsarnau", Code: "TAL"},
+	{Name: "Talybont", Code: "TLB"},
+	{Name: "Tal-y-Cafn", Code: "TLC"},
+	{Name: "Tame Bridge Parkway", Code: "TAB"},
+	{Name: "Tamworth", Code: "TAM"},
+	{Name: "Taplow", Code: "TAP"},
+	{Name: "Tattenham Corner", Code: "TAT"},
+	{Name: "Taunton", Code: "TAU"},
+	{Name: "Taynuilt", Code: "TAY"},
+	{Name: "Teddington", Code: "TED"},
+	{Name: "Tees-side Airport", Code: "TEA"},
+	{Name: "Teignmouth", Code: "TGM"},
+	{Name: "Telford Central", Code: "TFC"},
+	{Name: "Templecombe", Code: "TMC"},
+	{Name: "Tenby", Code: "TEN"},
+	{Name: "Teynham", Code: "TEY"},
+	{Name: "Thames Ditton", Code: "THD"},
+	{Name: "Thatcham", Code: "THA"},
+	{Name: "Thatto Heath", Code: "THH"},
+	{Name: "The Hawthorns", Code: "THW"},
+	{Name: "The Lakes (Warks)", Code: "TLK"},
+	{Name: "Theale", Code: "THE"},
+	{Name: "Theobalds Grove", Code: "TEO"},
+	{Name: "Thetford", Code: "TTF"},
+	{Name: "Thirsk", Code: "THI"},
+	{Name: "Thornaby", Code: "TBY"},
+	{Name: "Thorne North", Code: "TNN"},
+	{Name: "Thorne South", Code: "TNS"},
+	{Name: "Thornford", Code: "THO"},
+	{Name: "Thornliebank", Code: "THB"},
+	{Name: "Thornton Abbey", Code: "TNA"},
+	{Name: "Thornton Heath", Code: "TTH"},
+	{Name: "Thorntonhall", Code: "THT"},
+	{Name: "Thorpe Bay", Code: "TPB"},
+	{Name: "Thorpe Culvert", Code: "TPC"},
+	{Name: "Thorpe-le-Soken", Code: "TLS"},
+	{Name: "Three Bridges", Code: "TBD"},
+	{Name: "Three Oaks", Code: "TOK"},
+	{Name: "Thurgarton", Code: "THU"},
+	{Name: "Thurnscoe", Code: "THC"},
+	{Name: "Thurso", Code: "THS"},
+	{Name: "Thurston", Code: "TRS"},
+	{Name: "Tilbury Town", Code: "TIL"},
+	{Name: "Tile Hill", Code: "THL"},
+	{Name: "Tilehurst", Code: "TLH"},
+	{Name: "Tipton", Code: "TIP"},
+	{Name: "Tir-Phil", Code: "TIR"},
+	{Name: "Tisbury", Code: "TIS"},
+	{Name: "Tiverton Parkway", Code: "TVP"},
+	{Name: "Todmorden", Code: "TOD"},
+	{Name: "Tolworth", Code: "TOL"},
+	{Name: "Ton Pentre", Code: "TPN"},
+	{Name: "Tonbridge", Code: "TON"},
+	{Name: "Tondu", Code: "TDU"},
+	{Name: "Tonfanau", Code: "TNF"},
+	{Name: "Tonypandy", Code: "TNP"},
+	{Name: "Tooting", Code: "TOO"},
+	{Name: "Topsham", Code: "TOP"},
+	{Name: "Torquay", Code: "TQY"},
+	{Name: "Torre", Code: "TRR"},
+	{Name: "Totnes", Code: "TOT"},
+	{Name: "Tottenham Hale", Code: "TOM"},
+	{Name: "Totton", Code: "TTN"},
+	{Name: "Town Green", Code: "TWN"},
+	{Name: "Trafford Park", Code: "TRA"},
+	{Name: "Trefforest", Code: "TRF"},
+	{Name: "Trefforest Estate", Code: "TRE"},
+	{Name: "Trehafod", Code: "TRH"},
+	{Name: "Treherbert", Code: "TRB"},
+	{Name: "Treorchy", Code: "TRY"},
+	{Name: "Trimley", Code: "TRM"},
+	{Name: "Tring", Code: "TRI"},
+	{Name: "Troed-y-rhiw", Code: "TRD"},
+	{Name: "Troon", Code: "TRN"},
+	{Name: "Trowbridge", Code: "TRO"},
+	{Name: "Truro", Code: "TRU"},
+	{Name: "Tulloch", Code: "TUL"},
+	{Name: "Tulse Hill", Code: "TUH"},
+	{Name: "Tunbridge Wells", Code: "TBW"},
+	{Name: "Turkey Street", Code: "TUR"},
+	{Name: "Tutbury & Hatton", Code: "TUT"},
+	{Name: "Tweedbank", Code: "TWB"},
+	{Name: "Twickenham", Code: "TWI"},
+	{Name: "Twyford", Code: "TWY"},
+	{Name: "Ty Croes", Code: "TYC"},
+	{Name: "Ty Glas", Code: "TGS"},
+	{Name: "Tygwyn", Code: "TYG"},
+	{Name: "Tyndrum Lower", Code: "TYL"},
+	{Name: "Tyseley", Code: "TYS"},
+	{Name: "Tywyn", Code: "TYW"},
+	{Name: "Uckfield", Code: "UCK"},
+	{Name: "Uddingston", Code: "UDD"},
+	{Name: "Ulceby", Code: "ULC"},
+	{Name: "Ulleskelf", Code: "ULL"},
+	{Name: "Ulverston", Code: "ULV"},
+	{Name: "Umberleigh", Code: "UMB"},
+	{Name: "University (Birmingham)", Code: "UNI"},
+	{Name: "Uphall", Code: "UHA"},
+	{Name: "Upholland", Code: "UPL"},
+	{Name: "Upminster", Code: "UPM"},
+	{Name: "Upper Halliford", Code: "UPH"},
+	{Name: "Upper Holloway", Code: "UHL"},
+	{Name: "Upper Tyndrum", Code: "UTY"},
+	{Name: "Upper Warlingham", Code: "UWL"},
+	{Name: "Upton (Merseyside)", Code: "UPT"},
+	{Name: "Upwey", Code: "UPW"},
+	{Name: "Urmston", Code: "URM"},
+	{Name: "Uttoxeter", Code: "UTT"},
+	{Name: "Valley", Code: "VAL"},
+	{Name: "Vauxhall", Code: "VXH"},
+	{Name: "Virginia Water", Code: "VIR"},
+	{Name: "Waddon", Code: "WDO"},
+	{Name: "Wadhurst", Code: "WAD"},
+	{Name: "Wainfleet", Code: "WFL"},
+	{Name: "Wakefield Kirkgate", Code: "WKK"},
+	{Name: "Wakefield Westgate", Code: "WKF", TIPLOC: "WKFLDWG", Lat: 53.6833, Lon: -1.5066, Country: England},
+	{Name: "Walkden", Code: "WKD"},
+	{Name: "Wallasey Grove Road", Code: "WLG"},
+	{Name: "Wallasey Village", Code: "WLV"},
+	{Name: "Wallington", Code: "WLT"},
+	{Name: "Wallyford", Code: "WAF"},
+	{Name: "Walmer", Code: "WAM"},
+	{Name: "Walsall", Code: "WSL"},
+	{Name: "Walsden", Code: "WDN"},
+	{Name: "Waltham Cross", Code: "WLC"},
+	{Name: "Walthamstow Central", Code: "WHC"},
+	{Name: "Walthamstow Queen's Road", Code: "WMW"},
+	{Name: "Walton (Merseyside)", Code: "WAO"},
+	{Name: "Walton-on-Thames", Code: "WAL"},
+	{Name: "Walton-on-the-Naze", Code: "WON"},
+	{Name: "Wanborough", Code: "WAN"},
+	{Name: "Wandsworth Common", Code: "WSW"},
+	{Name: "Wandsworth Road", Code: "WWR"},
+	{Name: "Wandsworth Town", Code: "WNT"},
+	{Name: "Wanstead Park", Code: "WNP"},
+	{Name: "Wapping", Code: "WPE"},
+	{Name: "Warblington", Code: "WBL"},
+	{Name: "Ware (Herts)", Code: "WAR"},
+	{Name: "Wareham (Dorset)", Code: "WRM"},
+	{Name: "Wargrave", Code: "WGV"},
+	{Name: "Warminster", Code: "WMN"},
+	{Name: "Warnham", Code: "WNH"},
+	{Name: "Warrington Bank Quay", Code: "WBQ", TIPLOC: "WARRBQ", Lat: 53.3872, Lon: -2.6018, Country: England},
+	{Name: "Warrington Central", Code: "WAC"},
+	{Name: "Warwick", Code: "WRW"},
+	{Name: "Warwick Parkway", Code: "WRP"},
+	{Name: "Water Orton", Code: "WTO"},
+	{Name: "Waterbeach", Code: "WBC"},
+	{Name: "Wateringbury", Code: "WTR"},
+	{Name: "Waterloo (Merseyside)", Code: "WLO"},
+	{Name: "Watford High Street", Code: "WFH"},
+	{Name: "Watford Junction", Code: "WFJ"},
+	{Name: "Watford North", Code: "WFN"},
+	{Name: "Watlington", Code: "WTG"},
+	{Name: "Watton-at-Stone", Code: "WAS"},
+	{Name: "Waun-Gron Park", Code: "WNG"},
+	{Name: "Wavertree Technology Park", Code: "WAV"},
+	{Name: "Wedgwood", Code: "WED"},
+	{Name: "Weeley", Code: "WEE"},
+	{Name: "Weeton", Code: "WET"},
+	{Name: "Welham Green", Code: "WMG"},
+	{Name: "Welling", Code: "WLI"},
+	{Name: "Wellingborough", Code: "WEL"},
+	{Name: "Wellington (Shropshire)", Code: "WLN"},
+	{Name: "Welshpool", Code: "WLP"},
+	{Name: "Welwyn Garden City", Code: "WGC"},
+	{Name: "Welwyn North", Code: "WLW"},
+	{Name: "Wem", Code: "WEM"},
+	{Name: "Wembley Central", Code: "WMB"},
+	{Name: "Wembley Stadium", Code: "WCX"},
+	{Name: "Wemyss Bay", Code: "WMS"},
+	{Name: "Wendover", Code: "WND"},
+	{Name: "Wennington", Code: "WNN"},
+	{Name: "West Allerton", Code: "WSA"},
+	{Name: "West Brompton", Code: "WBP"},
+	{Name: "West Byfleet", Code: "WBY"},
+	{Name: "West Calder", Code: "WCL"},
+	{Name: "West Croydon", Code: "WCY"},
+	{Name: "West Drayton", Code: "WDT"},
+	{Name: "West Dulwich", Code: "WDU"},
+	{Name: "West Ealing", Code: "WEA"},
+	{Name: "West Ham", Code: "WEH"},
+	{Name: "West Hampstead", Code: "WHD"},
+	{Name: "West Hampstead Thameslink", Code: "WHP"},
+	{Name: "West Horndon", Code: "WHR"},
+	{Name: "West Kilbride", Code: "WKB"},
+	{Name: "West Kirby", Code: "WKI"},
+	{Name: "West Malling", Code: "WMA"},
+	{Name: "West Norwood", Code: "WNW"},
+	{Name: "West Ruislip", Code: "WRU"},
+	{Name: "West Runton", Code: "WRN"},
+	{Name: "West St Leonards", Code: "WLD"},
+	{Name: "West Sutton", Code: "WSU"},
+	{Name: "West Wickham", Code: "WWI"},
+	{Name: "West Worthing", Code: "WWO"},
+	{Name: "Westbury (Wilts)", Code: "WSB"},
+	{Name: "Westcliff", Code: "WCF"},
+	{Name: "Westcombe Park", Code: "WCB"},
+	{Name: "Westenhanger", Code: "WHA"},
+	{Name: "Wester Hailes", Code: "WTA"},
+	{Name: "Westerfield", Code: "WFI"},
+	{Name: "Westerton", Code: "WES"},
+	{Name: "Westgate-on-Sea", Code: "WGA"},
+	{Name: "Westhoughton", Code: "WHG"},
+	{Name: "Weston Milton", Code: "WNM"},
+	{Name: "Weston-super-Mare", Code: "WSM"},
+	{Name: "Wetheral", Code: "WRL"},
+	{Name: "Weybridge", Code: "WYB"},
+	{Name: "Weymouth", Code: "WEY"},
+	{Name: "Whaley Bridge", Code: "WBR"},
+	{Name: "Whalley (Lancs)", Code: "WHE"},
+	{Name: "Whatstandwell", Code: "WTS"},
+	{Name: "Whifflet", Code: "WFF"},
+	{Name: "Whimple", Code: "WHM"},
+	{Name: "Whinhill", Code: "WNL"},
+	{Name: "Whiston", Code: "WHN"},
+	{Name: "Whitby", Code: "WTB"},
+	{Name: "Whitchurch (Cardiff)", Code: "WHT"},
+	{Name: "Whitchurch (Hants)", Code: "WCH"},
+	{Name: "Whitchurch (Shropshire)", Code: "WTC"},
+	{Name: "White Hart Lane", Code: "WHL"},
+	{Name: "White Notley", Code: "WNY"},
+	{Name: "Whitechapel", Code: "ZLW"},
+	{Name: "Whitecraigs", Code: "WCR"},
+	{Name: "Whitehaven", Code: "WTH"},
+	{Name: "Whitland", Code: "WTL"},
+	{Name: "Whitley Bridge", Code: "WBD"},
+	{Name: "Whitlocks End", Code: "WTE"},
+	{Name: "Whitstable", Code: "WHI"},
+	{Name: "Whittlesea", Code: "WLE"},
+	{Name: "Whittlesford Parkway", Code: "WLF"},
+	{Name: "Whitton (London)", Code: "WTN"},
+	{Name: "Whitwell (Derbyshire)", Code: "WWL"},
+	{Name: "Whyteleafe", Code: "WHY"},
+	{Name: "Whyteleafe South", Code: "WHS"},
+	{Name: "Wick", Code: "WCK"},
+	{Name: "Wickford", Code: "WIC"},
+	{Name: "Wickham Market", Code: "WCM"},
+	{Name: "Widdrington", Code: "WDD"},
+	{Name: "Widnes", Code: "WID"},
+	{Name: "Widney Manor", Code: "WMR"},
+	{Name: "Wigan North Western", Code: "WGN"},
+	{Name: "Wigan Wallgate", Code: "WGW"},
+	{Name: "Wigton", Code: "WGT"},
+	{Name: "Wildmill", Code: "WMI"},
+	{Name: "Willesden Junction", Code: "WIJ"},
+	{Name: "Williamwood", Code: "WLM"},
+	{Name: "Willington", Code: "WIL"},
+	{Name: "Wilmcote", Code: "WMC"},
+	{Name: "Wilmslow", Code: "WML"},
+	{Name: "Wilnecote (Staffs)", Code: "WNE"},
+	{Name: "Wimbledon", Code: "WIM"},
+	{Name: "Wimbledon Chase", Code: "WBO"},
+	{Name: "Winchelsea", Code: "WSE"},
+	{Name: "Winchester", Code: "WIN"},
+	{Name: "Winchfield", Code: "WNF"},
+	{Name: "Winchmore Hill", Code: "WIH"},
+	{Name: "Windermere", Code: "WDM"},
+	{Name: "Windsor & Eton Central", Code: "WNC"},
+	{Name: "Windsor & Eton Riverside", Code: "WNR"},
+	{Name: "Winnersh", Code: "WNS"},
+	{Name: "Winnersh Triangle", Code: "WTI"},
+	{Name: "Winsford", Code: "WSF"},
+	{Name: "Wishaw", Code: "WSH"},
+	{Name: "Witham", Code: "WTM"},
+	{Name: "Witley", Code: "WTY"},
+	{Name: "Witton (West Midlands)", Code: "WTT"},
+	{Name: "Wivelsfield", Code: "WVF"},
+	{Name: "Wivenhoe", Code: "WIV"},
+	{Name: "Woburn Sands", Code: "WOB"},
+	{Name: "Woking", Code: "WOK"},
+	{Name: "Wokingham", Code: "WKM"},
+	{Name: "Woldingham", Code: "WOH"},
+	{Name: "Wolverhampton", Code: "WVH"},
+	{Name: "Wolverton", Code: "WOL"},
+	{Name: "Wombwell", Code: "WOM"},
+	{Name: "Wood End", Code: "WDE"},
+	{Name: "Wood Street", Code: "WST"},
+	{Name: "Woodbridge", Code: "WDB"},
+	{Name: "Woodgrange Park", Code: "WGR"},
+	{Name: "Woodhall", Code: "WDL"},
+	{Name: "Woodhouse", Code: "WDH"},
+	{Name: "Woodlesford", Code: "WDS"},
+	{Name: "Woodley", Code: "WLY"},
+	{Name: "Woodmansterne", Code: "WME"},
+	{Name: "Woodsmoor", Code: "WSR"},
+	{Name: "Wool", Code: "WOO"},
+	{Name: "Woolston", Code: "WLS"},
+	{Name: "Woolwich Arsenal", Code: "WWA"},
+	{Name: "Woolwich Dockyard", Code: "WWD"},
+	{Name: "Wootton Wawen", Code: "WWW"},
+	{Name: "Worcester Foregate Street", Code: "WOF"},
+	{Name: "Worcester Park", Code: "WCP"},
+	{Name: "Worcester Shrub Hill", Code: "WOS"},
+	{Name: "Workington", Code: "WKG"},
+	{Name: "Worksop", Code: "WRK"},
+	{Name: "Worle", Code: "WOR"},
+	{Name: "Worplesdon", Code: "WPL"},
+	{Name: "Worstead", Code: "WRT"},
+	{Name: "Worthing", Code: "WRH"},
+	{Name: "Wrabness", Code: "WRB"},
+	{Name: "Wraysbury", Code: "WRY"},
+	{Name: "Wrenbury", Code: "WRE"},
+	{Name: "Wressle", Code: "WRS"},
+	{Name: "Wrexham Central", Code: "WXC"},
+	{Name: "Wrexham General", Code: "WRX"},
+	{Name: "Wye", Code: "WYE"},
+	{Name: "Wylam", Code: "WYM"},
+	{Name: "Wylde Green", Code: "WYL"},
+	{Name: "Wymondham", Code: "WMD"},
+	{Name: "Wythall", Code: "WYT"},
+	{Name: "Yalding", Code: "YAL"},
+	{Name: "Yardley Wood", Code: "YRD"},
+	{Name: "Yarm", Code: "YRM"},
+	{Name: "Yate", Code: "YAE"},
+	{Name: "Yatton", Code: "YAT"},
+	{Name: "Yeoford", Code: "YEO"},
+	{Name: "Yeovil Junction", Code: "YVJ"},
+	{Name: "Yeovil Pen Mill", Code: "YVP"},
+	{Name: "Yetminster", Code: "YET"},
+	{Name: "Ynyswen", Code: "YNW"},
+	{Name: "Yoker", Code: "YOK"},
+	{Name: "York", Code: "YRK", TIPLOC: "YORK", Lat: 53.9580, Lon: -1.0931, Country: England},
+	{Name: "Yorton", Code: "YRT"},
+	{Name: "Ystrad Mynach", Code: "YSM"},
+	{Name: "Ystrad Rhondda", Code: "YSR"},
+}
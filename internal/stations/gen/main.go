@@ -0,0 +1,360 @@
+// Command gen regenerates the station and postcode data in this package from
+// public datasets. Download them, then from the repository root run:
+//
+//	NAPTAN_XML=NaPTAN.xml NPTG_XML=NPTG.xml ONSPD_CSV=ONSPD.csv go generate ./internal/stations
+//
+// Either dataset can be left out to update only the other, and with neither
+// the checked-in data is left as it is.
+//
+// The Department for Transport's NaPTAN and NPTG, in XML form, fill in the
+// TIPLOCs, coordinates and countries in data.go, keeping the names and codes
+// maintained there by hand. NaPTAN gives each station's CRS code, TIPLOC,
+// coordinates and locality, and NPTG the region each locality is in, from
+// which the country follows. Stations missing from NaPTAN keep whatever
+// data.go already says.
+//
+// The ONS Postcode Directory, in CSV form, replaces the outcode table in
+// outcodes.go, placing each outcode at the middle of its live postcodes.
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	naptanPath := flag.String("naptan", "", "NaPTAN XML file, to update the stations")
+	nptgPath := flag.String("nptg", "", "NPTG XML file, to update the stations")
+	dataPath := flag.String("data", "data.go", "Go file holding the Stations table")
+	onspdPath := flag.String("onspd", "", "ONS Postcode Directory CSV file, to update the outcodes")
+	outcodesPath := flag.String("outcodes", "outcodes.go", "Go file to write the outcode table to")
+	flag.Parse()
+
+	err := run(*naptanPath, *nptgPath, *dataPath, *onspdPath, *outcodesPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(naptanPath, nptgPath, dataPath, onspdPath, outcodesPath string) error {
+	// A plain go generate ./... leaves the checked-in data alone
+	if naptanPath == "" && nptgPath == "" && onspdPath == "" {
+		fmt.Fprintln(os.Stderr, "gen: no datasets given, nothing to update (set NAPTAN_XML and NPTG_XML, or ONSPD_CSV)")
+		return nil
+	}
+	if (naptanPath == "") != (nptgPath == "") {
+		return errors.New("-naptan and -nptg must be given together")
+	}
+	if naptanPath != "" {
+		if err := updateStations(naptanPath, nptgPath, dataPath); err != nil {
+			return err
+		}
+	}
+	if onspdPath != "" {
+		if err := writeOutcodes(onspdPath, outcodesPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateStations fills in the TIPLOCs, coordinates and countries in the
+// Stations table from NaPTAN and NPTG.
+func updateStations(naptanPath, nptgPath, dataPath string) error {
+	countries, err := readNPTG(nptgPath)
+	if err != nil {
+		return fmt.Errorf("reading NPTG: %w", err)
+	}
+	railStops, err := readNaPTAN(naptanPath)
+	if err != nil {
+		return fmt.Errorf("reading NaPTAN: %w", err)
+	}
+
+	src, err := os.ReadFile(dataPath)
+	if err != nil {
+		return err
+	}
+	table, err := parseTable(src)
+	if err != nil {
+		return fmt.Errorf("%s: %w", dataPath, err)
+	}
+
+	var missing int
+	for _, row := range table.rows {
+		stop, ok := pickStop(railStops[row.unquote("Code")], row.unquote("TIPLOC"))
+		if !ok {
+			if row.fields["Lat"] == "" {
+				missing++
+			}
+			continue
+		}
+		row.fields["TIPLOC"] = strconv.Quote(stop.TIPLOC)
+		if stop.Lat != 0 || stop.Lon != 0 {
+			row.fields["Lat"] = strconv.FormatFloat(stop.Lat, 'f', 4, 64)
+			row.fields["Lon"] = strconv.FormatFloat(stop.Lon, 'f', 4, 64)
+		}
+		if country, ok := countries[stop.Locality]; ok {
+			row.fields["Country"] = country
+		}
+	}
+
+	out, err := format.Source(table.render(src))
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(dataPath, out, 0o644); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "%d stations, %d still without a location\n", len(table.rows), missing)
+	return nil
+}
+
+// railStop is a NaPTAN rail station access area.
+type railStop struct {
+	TIPLOC   string
+	Lat, Lon float64
+	Locality string // NPTG locality code
+}
+
+// pickStop chooses the stop for a station from those sharing its CRS code:
+// the one with the TIPLOC data.go already has, if there is one, or else the
+// first listed.
+func pickStop(stops []railStop, tiploc string) (railStop, bool) {
+	if len(stops) == 0 {
+		return railStop{}, false
+	}
+	for _, s := range stops {
+		if s.TIPLOC == tiploc {
+			return s, true
+		}
+	}
+	return stops[0], true
+}
+
+type naptanStopPoint struct {
+	Status   string `xml:"Status,attr"`
+	Locality string `xml:"Place>NptgLocalityRef"`
+	Location struct {
+		Longitude   float64 `xml:"Longitude"`
+		Latitude    float64 `xml:"Latitude"`
+		Translation struct {
+			Longitude float64 `xml:"Longitude"`
+			Latitude  float64 `xml:"Latitude"`
+		} `xml:"Translation"`
+	} `xml:"Place>Location"`
+	StopType string `xml:"StopClassification>StopType"`
+	RailRefs []struct {
+		TIPLOC string `xml:"TiplocRef"`
+		CRS    string `xml:"CrsRef"`
+	} `xml:"StopClassification>OffStreet>Rail>AnnotatedRailRef"`
+}
+
+// readNaPTAN returns the active rail stations in a NaPTAN XML file, by CRS
+// code, in the order they are listed.
+func readNaPTAN(path string) (map[string][]railStop, error) {
+	stops := make(map[string][]railStop)
+	err := decodeEach(path, "StopPoint", func(d *xml.Decoder, start xml.StartElement) error {
+		var sp naptanStopPoint
+		if err := d.DecodeElement(&sp, &start); err != nil {
+			return err
+		}
+		if sp.StopType != "RLY" || (sp.Status != "" && sp.Status != "active") {
+			return nil
+		}
+		lat, lon := sp.Location.Latitude, sp.Location.Longitude
+		if lat == 0 && lon == 0 {
+			lat, lon = sp.Location.Translation.Latitude, sp.Location.Translation.Longitude
+		}
+		for _, ref := range sp.RailRefs {
+			crs := strings.ToUpper(strings.TrimSpace(ref.CRS))
+			if crs == "" || ref.TIPLOC == "" {
+				continue
+			}
+			stops[crs] = append(stops[crs], railStop{
+				TIPLOC:   strings.ToUpper(strings.TrimSpace(ref.TIPLOC)),
+				Lat:      lat,
+				Lon:      lon,
+				Locality: sp.Locality,
+			})
+		}
+		return nil
+	})
+	return stops, err
+}
+
+type nptgRegion struct {
+	Code  string   `xml:"RegionCode"`
+	Areas []string `xml:"AdministrativeAreas>AdministrativeArea>AdministrativeAreaCode"`
+}
+
+type nptgLocality struct {
+	Code string `xml:"NptgLocalityCode"`
+	Area string `xml:"AdministrativeAreaRef"`
+}
+
+// readNPTG returns the country of each locality in an NPTG XML file, as the
+// name of the Country constant in data.go.
+func readNPTG(path string) (map[string]string, error) {
+	areaCountry := make(map[string]string)
+	localityArea := make(map[string]string)
+	err := decodeEach(path, "", func(d *xml.Decoder, start xml.StartElement) error {
+		switch start.Name.Local {
+		case "Region":
+			var r nptgRegion
+			if err := d.DecodeElement(&r, &start); err != nil {
+				return err
+			}
+			country := "England"
+			switch r.Code {
+			case "S":
+				country = "Scotland"
+			case "W":
+				country = "Wales"
+			case "GB":
+				return nil // national areas, such as the one for all rail stations
+			}
+			for _, area := range r.Areas {
+				areaCountry[area] = country
+			}
+		case "NptgLocality":
+			var l nptgLocality
+			if err := d.DecodeElement(&l, &start); err != nil {
+				return err
+			}
+			localityArea[l.Code] = l.Area
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	countries := make(map[string]string, len(localityArea))
+	for locality, area := range localityArea {
+		if country, ok := areaCountry[area]; ok {
+			countries[locality] = country
+		}
+	}
+	return countries, nil
+}
+
+// decodeEach streams an XML file, calling fn for each element named name, or
+// for every element if name is "". fn must consume the element it is given,
+// or return without touching the decoder to descend into it.
+func decodeEach(path, name string, fn func(*xml.Decoder, xml.StartElement) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	d := xml.NewDecoder(f)
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || (name != "" && start.Name.Local != name) {
+			continue
+		}
+		if err := fn(d, start); err != nil {
+			return err
+		}
+	}
+}
+
+// fieldOrder is the order fields are written in each row of the table.
+var fieldOrder = []string{"Name", "Code", "TIPLOC", "Lat", "Lon", "Country"}
+
+// table is the Stations composite literal in data.go.
+type table struct {
+	lbrace, rbrace int // offsets of the literal's braces in the source
+	rows           []row
+}
+
+// row is one station, as the source text of each field that is set.
+type row struct {
+	fields map[string]string
+}
+
+func (r row) unquote(field string) string {
+	s, err := strconv.Unquote(r.fields[field])
+	if err != nil {
+		return ""
+	}
+	return s
+}
+
+// parseTable finds the Stations table in src.
+func parseTable(src []byte) (*table, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		return nil, err
+	}
+	offset := func(p token.Pos) int { return fset.Position(p).Offset }
+
+	var lit *ast.CompositeLit
+	ast.Inspect(file, func(n ast.Node) bool {
+		if spec, ok := n.(*ast.ValueSpec); ok && len(spec.Names) == 1 && spec.Names[0].Name == "Stations" && len(spec.Values) == 1 {
+			lit, _ = spec.Values[0].(*ast.CompositeLit)
+		}
+		return lit == nil
+	})
+	if lit == nil {
+		return nil, errors.New("no Stations table")
+	}
+
+	t := &table{lbrace: offset(lit.Lbrace), rbrace: offset(lit.Rbrace)}
+	for _, elt := range lit.Elts {
+		station, ok := elt.(*ast.CompositeLit)
+		if !ok {
+			return nil, fmt.Errorf("unexpected %T in Stations", elt)
+		}
+		r := row{fields: make(map[string]string)}
+		for _, e := range station.Elts {
+			kv, ok := e.(*ast.KeyValueExpr)
+			if !ok {
+				return nil, errors.New("stations must use field names")
+			}
+			key := kv.Key.(*ast.Ident).Name
+			r.fields[key] = string(src[offset(kv.Value.Pos()):offset(kv.Value.End())])
+		}
+		t.rows = append(t.rows, r)
+	}
+	return t, nil
+}
+
+// render returns src with the table's rows rewritten.
+func (t *table) render(src []byte) []byte {
+	var b bytes.Buffer
+	b.Write(src[:t.lbrace+1])
+	b.WriteString("\n")
+	for _, r := range t.rows {
+		var fields []string
+		for _, key := range fieldOrder {
+			if v := r.fields[key]; v != "" {
+				fields = append(fields, key+": "+v)
+			}
+		}
+		fmt.Fprintf(&b, "\t{%s},\n", strings.Join(fields, ", "))
+	}
+	b.Write(src[t.rbrace:])
+	return b.Bytes()
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"go/format"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// writeOutcodes writes the outcode table, placing each outcode at the middle
+// of its live postcodes in an ONS Postcode Directory CSV file.
+func writeOutcodes(onspdPath, outcodesPath string) error {
+	centres, err := readONSPD(onspdPath)
+	if err != nil {
+		return fmt.Errorf("reading the ONS Postcode Directory: %w", err)
+	}
+
+	var b bytes.Buffer
+	b.WriteString(`// Code generated by gen from the ONS Postcode Directory; DO NOT EDIT.
+
+package stations
+
+// outcodes maps postcode outcodes, the part before the space, to the middle of
+// the live postcodes in each.
+var outcodes = map[string][2]float64{
+`)
+	for _, outcode := range slices.Sorted(maps.Keys(centres)) {
+		c := centres[outcode]
+		fmt.Fprintf(&b, "\t%q: {%s, %s},\n", outcode,
+			strconv.FormatFloat(c[0], 'f', 4, 64), strconv.FormatFloat(c[1], 'f', 4, 64))
+	}
+	b.WriteString("}\n")
+
+	out, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(outcodesPath, out, 0o644); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "%d outcodes\n", len(centres))
+	return nil
+}
+
+// readONSPD returns the average position of the live postcodes in each
+// outcode. Postcodes without a grid reference, which the directory gives a
+// latitude of 99.999999, are left out.
+func readONSPD(path string) (map[string][2]float64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.ReuseRecord = true
+	header, err := r.Read()
+	if err != nil {
+		return nil, err
+	}
+	col := make(map[string]int, len(header))
+	for i, name := range header {
+		col[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"pcds", "doterm", "lat", "long"} {
+		if _, ok := col[name]; !ok {
+			return nil, fmt.Errorf("no %s column", name)
+		}
+	}
+
+	type sum struct {
+		lat, lon float64
+		n        int
+	}
+	sums := make(map[string]*sum)
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(rec[col["doterm"]]) != "" {
+			continue // terminated
+		}
+		outcode, _, _ := strings.Cut(strings.TrimSpace(rec[col["pcds"]]), " ")
+		lat, err1 := strconv.ParseFloat(rec[col["lat"]], 64)
+		lon, err2 := strconv.ParseFloat(rec[col["long"]], 64)
+		if outcode == "" || err1 != nil || err2 != nil || lat > 90 {
+			continue
+		}
+		s := sums[outcode]
+		if s == nil {
+			s = &sum{}
+			sums[outcode] = s
+		}
+		s.lat, s.lon, s.n = s.lat+lat, s.lon+lon, s.n+1
+	}
+
+	centres := make(map[string][2]float64, len(sums))
+	for outcode, s := range sums {
+		centres[outcode] = [2]float64{s.lat / float64(s.n), s.lon / float64(s.n)}
+	}
+	return centres, nil
+}
@@ -0,0 +1,107 @@
+package stations
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// GroupPrefix starts the code of every station group, e.g. "G:LON".
+const GroupPrefix = "G:"
+
+// Group is a set of stations that serve the same place, such as the London
+// termini, so a search can start or end at any of them. Wherever a station
+// is looked up by code or name, a group is returned as a Station with the
+// group's code and name.
+type Group struct {
+	Code    string   // e.g. "G:LON"
+	Name    string   // e.g. "London (any)"
+	Members []string // CRS codes of the stations in the group, most important first
+}
+
+// Station returns the group in the form station lookups return it.
+func (g Group) Station() Station {
+	return Station{Name: g.Name, Code: g.Code}
+}
+
+// groups holds the built-in groups, followed by any added with AddGroup.
+var groups = []Group{
+	{Code: "G:LON", Name: "London (any)", Members: []string{
+		"EUS", "KGX", "STP", "PAD", "LST", "WAT", "VIC", "CHX", "CST", "LBG", "MYB", "FST",
+	}},
+	{Code: "G:BHM", Name: "Birmingham (any)", Members: []string{"BHM", "BMO", "BSW"}},
+	{Code: "G:MAN", Name: "Manchester (any)", Members: []string{"MAN", "MCV", "MCO", "DGT"}},
+	{Code: "G:GLA", Name: "Glasgow (any)", Members: []string{"GLC", "GLQ"}},
+}
+
+// IsGroup reports whether code is a station group code rather than a CRS code.
+func IsGroup(code string) bool {
+	return strings.HasPrefix(strings.ToUpper(strings.TrimSpace(code)), GroupPrefix)
+}
+
+// Groups returns every station group, built-in ones first.
+func Groups() []Group {
+	return slices.Clone(groups)
+}
+
+// GroupByCode returns the group with the given code, ignoring case.
+func GroupByCode(code string) (Group, bool) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	for _, g := range groups {
+		if g.Code == code {
+			return g, true
+		}
+	}
+	return Group{}, false
+}
+
+// groupByName returns the group with the given name, compared after Normalize
+// and with or without an "(any)" at the end.
+func groupByName(name string) (Group, bool) {
+	name = Normalize(name)
+	for _, g := range groups {
+		if Normalize(g.Name) == name || g.normalizedName() == name {
+			return g, true
+		}
+	}
+	return Group{}, false
+}
+
+// normalizedName returns the group's normalised name without any "(any)" at
+// the end, so "home" is as close a match for "Home (any)" as can be.
+func (g Group) normalizedName() string {
+	return strings.TrimSuffix(Normalize(g.Name), " any")
+}
+
+// AddGroup adds a group, such as one defined in the user's config, replacing
+// any existing group with the same code. Its code must start with GroupPrefix
+// and its members must all be known stations.
+func AddGroup(g Group) error {
+	g.Code = strings.ToUpper(strings.TrimSpace(g.Code))
+	if !strings.HasPrefix(g.Code, GroupPrefix) || len(g.Code) == len(GroupPrefix) {
+		return fmt.Errorf("station group code %q must start with %s", g.Code, GroupPrefix)
+	}
+	if strings.TrimSpace(g.Name) == "" {
+		return fmt.Errorf("station group %s has no name", g.Code)
+	}
+	if len(g.Members) == 0 {
+		return fmt.Errorf("station group %s has no stations", g.Code)
+	}
+
+	members := make([]string, len(g.Members))
+	for i, code := range g.Members {
+		s, ok := ByCode(code)
+		if !ok || IsGroup(s.Code) {
+			return fmt.Errorf("station group %s: unknown station code %q", g.Code, code)
+		}
+		members[i] = s.Code
+	}
+	g.Members = members
+
+	if i := slices.IndexFunc(groups, func(existing Group) bool { return existing.Code == g.Code }); i >= 0 {
+		groups[i] = g
+	} else {
+		groups = append(groups, g)
+	}
+	return nil
+}
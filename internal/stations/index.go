@@ -0,0 +1,122 @@
+package stations
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// index is built once from Stations so lookups don't scan the whole list.
+var index stationIndex
+
+func init() {
+	index = buildIndex()
+}
+
+type stationIndex struct {
+	byCode     map[string]int // CRS code to position in Stations
+	byTIPLOC   map[string]int
+	byName     map[string]int // normalised name to position in Stations
+	names      []nameEntry    // normalised names, sorted for prefix search
+	normalized []string       // normalised name of each station in Stations
+}
+
+type nameEntry struct {
+	name string
+	pos  int
+}
+
+// buildIndex indexes Stations, first tidying the whitespace in their names.
+func buildIndex() stationIndex {
+	idx := stationIndex{
+		byCode:     make(map[string]int, len(Stations)),
+		byTIPLOC:   make(map[string]int),
+		byName:     make(map[string]int, len(Stations)),
+		names:      make([]nameEntry, 0, len(Stations)),
+		normalized: make([]string, len(Stations)),
+	}
+	for i := range Stations {
+		s := &Stations[i]
+		s.Name = strings.Join(strings.Fields(s.Name), " ")
+		idx.byCode[s.Code] = i
+		if s.TIPLOC != "" {
+			idx.byTIPLOC[s.TIPLOC] = i
+		}
+		name := Normalize(s.Name)
+		idx.byName[name] = i
+		idx.normalized[i] = name
+		idx.names = append(idx.names, nameEntry{name: name, pos: i})
+	}
+	slices.SortFunc(idx.names, func(a, b nameEntry) int { return strings.Compare(a.name, b.name) })
+	return idx
+}
+
+// Normalize folds a station name for comparison: lower case, "&" spelled
+// "and", punctuation dropped and runs of spaces collapsed, so "Abergele &
+// Pensarn", "abergele and pensarn " and "Abergele and Pensarn" are equal.
+func Normalize(name string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r == '&':
+			b.WriteString(" and ")
+			space = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			space = false
+			b.WriteRune(r)
+		case unicode.IsSpace(r) || r == '-' || r == '(' || r == ')' || r == '/':
+			space = true
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// ByCode returns the station with the given CRS code, or the group with the
+// given group code, ignoring case.
+func ByCode(code string) (Station, bool) {
+	i, ok := index.byCode[strings.ToUpper(strings.TrimSpace(code))]
+	if !ok {
+		if g, ok := GroupByCode(code); ok {
+			return g.Station(), true
+		}
+		return Station{}, false
+	}
+	return Stations[i], true
+}
+
+// ByName returns the station or group with the given name, compared after
+// Normalize.
+func ByName(name string) (Station, bool) {
+	i, ok := index.byName[Normalize(name)]
+	if !ok {
+		if g, ok := groupByName(name); ok {
+			return g.Station(), true
+		}
+		return Station{}, false
+	}
+	return Stations[i], true
+}
+
+// WithPrefix returns the stations whose names start with prefix, compared
+// after Normalize, in alphabetical order.
+func WithPrefix(prefix string) []Station {
+	prefix = Normalize(prefix)
+	if prefix == "" {
+		return nil
+	}
+	start, _ := slices.BinarySearchFunc(index.names, prefix, func(e nameEntry, p string) int {
+		return strings.Compare(e.name, p)
+	})
+	var matches []Station
+	for _, e := range index.names[start:] {
+		if !strings.HasPrefix(e.name, prefix) {
+			break
+		}
+		matches = append(matches, Stations[e.pos])
+	}
+	return matches
+}
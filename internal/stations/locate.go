@@ -0,0 +1,92 @@
+package stations
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Locate returns the coordinates of a place, given as "lat,lon" in degrees, a
+// postcode or outcode in the offline table such as "N1C" or "N1C 4QP", or a
+// station whose coordinates are known, by code or name as for Resolve. A
+// station group is located at the middle of its members with known
+// coordinates.
+func Locate(place string) (lat, lon float64, err error) {
+	place = strings.TrimSpace(place)
+	if lat, lon, ok := parseLatLon(place); ok {
+		return lat, lon, nil
+	}
+	if outcode, ok := postcodeOutcode(place); ok {
+		c, ok := outcodes[outcode]
+		if !ok {
+			return 0, 0, fmt.Errorf("postcode area %s is not in the offline postcode table", outcode)
+		}
+		return c[0], c[1], nil
+	}
+
+	s, err := Resolve(place)
+	if err != nil {
+		return 0, 0, err
+	}
+	if g, ok := GroupByCode(s.Code); ok {
+		return groupCentre(g)
+	}
+	if !s.HasLocation() {
+		return 0, 0, fmt.Errorf("the location of %s is not known", s.Name)
+	}
+	return s.Lat, s.Lon, nil
+}
+
+// groupCentre returns the average position of a group's members whose
+// coordinates are known. Groups are a few stations in one town, so averaging
+// degrees is close enough.
+func groupCentre(g Group) (lat, lon float64, err error) {
+	var n int
+	for _, code := range g.Members {
+		if s, ok := ByCode(code); ok && s.HasLocation() {
+			lat, lon, n = lat+s.Lat, lon+s.Lon, n+1
+		}
+	}
+	if n == 0 {
+		return 0, 0, fmt.Errorf("the location of %s is not known", g.Name)
+	}
+	return lat / float64(n), lon / float64(n), nil
+}
+
+// parseLatLon parses "lat,lon" in degrees.
+func parseLatLon(s string) (lat, lon float64, ok bool) {
+	latStr, lonStr, found := strings.Cut(s, ",")
+	if !found {
+		return 0, 0, false
+	}
+	lat, err := strconv.ParseFloat(strings.TrimSpace(latStr), 64)
+	if err != nil || lat < -90 || lat > 90 {
+		return 0, 0, false
+	}
+	lon, err = strconv.ParseFloat(strings.TrimSpace(lonStr), 64)
+	if err != nil || lon < -180 || lon > 180 {
+		return 0, 0, false
+	}
+	return lat, lon, true
+}
+
+// postcodeOutcode returns the outcode of a postcode or outcode, e.g. "N1C"
+// for "n1c 4qp", if s looks like one.
+func postcodeOutcode(s string) (string, bool) {
+	s = strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+	if len(s) < 2 || len(s) > 7 {
+		return "", false
+	}
+	// A full postcode ends in an inward code of a digit and two letters
+	if n := len(s); n >= 5 && isDigit(s[n-3]) && isLetter(s[n-2]) && isLetter(s[n-1]) {
+		s = s[:n-3]
+	}
+	if len(s) < 2 || len(s) > 4 || !isLetter(s[0]) || !strings.ContainsFunc(s, unicode.IsDigit) {
+		return "", false
+	}
+	return s, true
+}
+
+func isDigit(b byte) bool  { return b >= '0' && b <= '9' }
+func isLetter(b byte) bool { return b >= 'A' && b <= 'Z' }
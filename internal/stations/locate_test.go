@@ -0,0 +1,133 @@
+package stations
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseLatLon(t *testing.T) {
+	tests := []struct {
+		in       string
+		lat, lon float64
+		ok       bool
+	}{
+		{"51.53,-0.12", 51.53, -0.12, true},
+		{" 51.53 , -0.12 ", 51.53, -0.12, true},
+		{"-33.9,151.2", -33.9, 151.2, true},
+		{"90,180", 90, 180, true},
+		{"90.1,0", 0, 0, false},
+		{"0,-180.5", 0, 0, false},
+		{"51.53", 0, 0, false},
+		{"51.53,", 0, 0, false},
+		{"north,west", 0, 0, false},
+		{"N1C", 0, 0, false},
+	}
+	for _, tt := range tests {
+		lat, lon, ok := parseLatLon(tt.in)
+		if ok != tt.ok || lat != tt.lat || lon != tt.lon {
+			t.Errorf("parseLatLon(%q) = %v, %v, %v, want %v, %v, %v", tt.in, lat, lon, ok, tt.lat, tt.lon, tt.ok)
+		}
+	}
+}
+
+func TestPostcodeOutcode(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		ok   bool
+	}{
+		{"N1C", "N1C", true},
+		{"n1c 4qp", "N1C", true},
+		{"N1C4QP", "N1C", true},
+		{" SW1A 1AA ", "SW1A", true},
+		{"M1 1AD", "M1", true},
+		{"M1", "M1", true},
+		{"EC1A", "EC1A", true},
+		{"LA9 4AA", "LA9", true},
+		{"KGX", "", false},         // a station code, not a postcode
+		{"London", "", false},      // no digits
+		{"1AD", "", false},         // starts with a digit
+		{"M", "", false},           // too short
+		{"SW1A1AAX", "", false},    // too long
+		{"ABCDE1", "", false},      // outcode too long
+		{"51.5,-0.1", "", false},   // coordinates
+		{"Kings Cross", "", false}, // a station name
+	}
+	for _, tt := range tests {
+		got, ok := postcodeOutcode(tt.in)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("postcodeOutcode(%q) = %q, %v, want %q, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestLocate(t *testing.T) {
+	kgx, _ := ByCode("KGX")
+	n1c := outcodes["N1C"]
+
+	tests := []struct {
+		place    string
+		lat, lon float64
+	}{
+		{"51.53,-0.12", 51.53, -0.12},
+		{"N1C", n1c[0], n1c[1]},
+		{"n1c 4qp", n1c[0], n1c[1]},
+		{"KGX", kgx.Lat, kgx.Lon},
+		{"kings cross", kgx.Lat, kgx.Lon},
+	}
+	for _, tt := range tests {
+		lat, lon, err := Locate(tt.place)
+		if err != nil {
+			t.Errorf("Locate(%q): %v", tt.place, err)
+			continue
+		}
+		if lat != tt.lat || lon != tt.lon {
+			t.Errorf("Locate(%q) = %v, %v, want %v, %v", tt.place, lat, lon, tt.lat, tt.lon)
+		}
+	}
+}
+
+func TestLocateGroup(t *testing.T) {
+	g, _ := GroupByCode("G:LON")
+	var sumLat, sumLon float64
+	var n int
+	for _, code := range g.Members {
+		if s, _ := ByCode(code); s.HasLocation() {
+			sumLat, sumLon, n = sumLat+s.Lat, sumLon+s.Lon, n+1
+		}
+	}
+	if n == 0 {
+		t.Skip("no London terminus has a location")
+	}
+
+	lat, lon, err := Locate("G:LON")
+	if err != nil {
+		t.Fatalf("Locate(G:LON): %v", err)
+	}
+	if math.Abs(lat-sumLat/float64(n)) > 1e-9 || math.Abs(lon-sumLon/float64(n)) > 1e-9 {
+		t.Errorf("Locate(G:LON) = %v, %v, want the middle of its %d located members", lat, lon, n)
+	}
+}
+
+func TestLocateErrors(t *testing.T) {
+	tests := []struct {
+		place string
+		want  string
+	}{
+		{"ZZ9 9ZZ", "not in the offline postcode table"},
+		{"qqqqqqq", "unknown station"},
+	}
+	// Until every station's location is known, one that isn't can't be located
+	if i := slices.IndexFunc(Stations, func(s Station) bool { return !s.HasLocation() }); i >= 0 {
+		s := Stations[i]
+		tests = append(tests, struct{ place, want string }{s.Code, "the location of " + s.Name + " is not known"})
+	}
+	for _, tt := range tests {
+		_, _, err := Locate(tt.place)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Locate(%q) error = %v, want one mentioning %q", tt.place, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package stations
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"sync"
+)
+
+// earthRadiusKm is the mean radius of the Earth, for haversine distances.
+const earthRadiusKm = 6371.0
+
+// cellSize is the side of a spatial grid cell in degrees, about 11 km north to
+// south and 7 km east to west in Great Britain.
+const cellSize = 0.1
+
+// Nearby is a station found by Near, with its distance from the point searched.
+type Nearby struct {
+	Station    Station
+	DistanceKm float64
+}
+
+// Distance returns the great-circle distance in kilometres between two points
+// given in degrees.
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	dLat := radians(lat2 - lat1)
+	dLon := radians(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(radians(lat1))*math.Cos(radians(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+// cell identifies a square of the spatial grid.
+type cell struct{ row, col int }
+
+func cellOf(lat, lon float64) cell {
+	return cell{int(math.Floor(lat / cellSize)), int(math.Floor(lon / cellSize))}
+}
+
+// grid buckets the stations whose coordinates are known by grid cell, so a
+// search only looks at the cells around the point searched.
+type grid struct {
+	cells    map[cell][]int // positions in Stations
+	min, max cell           // bounds of the occupied cells
+}
+
+var (
+	spatialOnce  sync.Once
+	spatialIndex grid
+)
+
+// spatial returns the grid, building it on first use.
+func spatial() *grid {
+	spatialOnce.Do(func() {
+		g := grid{cells: make(map[cell][]int)}
+		first := true
+		for i, s := range Stations {
+			if !s.HasLocation() {
+				continue
+			}
+			c := cellOf(s.Lat, s.Lon)
+			g.cells[c] = append(g.cells[c], i)
+			if first {
+				g.min, g.max, first = c, c, false
+			}
+			g.min = cell{min(g.min.row, c.row), min(g.min.col, c.col)}
+			g.max = cell{max(g.max.row, c.row), max(g.max.col, c.col)}
+		}
+		spatialIndex = g
+	})
+	return &spatialIndex
+}
+
+// Near returns the n stations closest to a point, nearest first. Only
+// stations whose coordinates are known are considered.
+func Near(lat, lon float64, n int) []Nearby {
+	g := spatial()
+	if n <= 0 || len(g.cells) == 0 {
+		return nil
+	}
+
+	// Search rings of cells outwards from the point's cell, until the next
+	// ring is further away than the nth station found so far
+	center := cellOf(lat, lon)
+	maxRing := max(abs(center.row-g.min.row), abs(center.row-g.max.row),
+		abs(center.col-g.min.col), abs(center.col-g.max.col))
+	var found []Nearby
+	for ring := 0; ring <= maxRing; ring++ {
+		for _, c := range ringCells(center, ring) {
+			for _, i := range g.cells[c] {
+				s := Stations[i]
+				found = append(found, Nearby{Station: s, DistanceKm: Distance(lat, lon, s.Lat, s.Lon)})
+			}
+		}
+		if len(found) >= n {
+			slices.SortFunc(found, byDistance)
+			if found[n-1].DistanceKm <= ringDistance(lat, ring) {
+				break
+			}
+		}
+	}
+
+	slices.SortFunc(found, byDistance)
+	return found[:min(n, len(found))]
+}
+
+// Within returns the stations within radiusKm of a point, nearest first.
+func Within(lat, lon, radiusKm float64) []Nearby {
+	var found []Nearby
+	for _, s := range Located() {
+		if d := Distance(lat, lon, s.Lat, s.Lon); d <= radiusKm {
+			found = append(found, Nearby{Station: s, DistanceKm: d})
+		}
+	}
+	slices.SortFunc(found, byDistance)
+	return found
+}
+
+// ringCells returns the cells on the square ring the given number of cells
+// out from center.
+func ringCells(center cell, ring int) []cell {
+	if ring == 0 {
+		return []cell{center}
+	}
+	var cells []cell
+	for d := -ring; d <= ring; d++ {
+		cells = append(cells,
+			cell{center.row - ring, center.col + d},
+			cell{center.row + ring, center.col + d})
+		if d != -ring && d != ring {
+			cells = append(cells,
+				cell{center.row + d, center.col - ring},
+				cell{center.row + d, center.col + ring})
+		}
+	}
+	return cells
+}
+
+// ringDistance returns a lower bound on the distance from a point to any cell
+// beyond the given ring around it. Degrees of longitude shrink towards the
+// poles, so the bound uses the furthest latitude the next ring reaches.
+func ringDistance(lat float64, ring int) float64 {
+	reach := float64(ring) * cellSize
+	furthest := math.Min(89, math.Abs(lat)+reach+cellSize)
+	return radians(reach) * earthRadiusKm * math.Cos(radians(furthest))
+}
+
+func byDistance(a, b Nearby) int {
+	return cmp.Compare(a.DistanceKm, b.DistanceKm)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
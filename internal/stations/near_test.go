@@ -0,0 +1,225 @@
+package stations
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// withStations replaces the station list for one test, so the spatial grid
+// is built from stations placed where the test needs them.
+func withStations(t *testing.T, list []Station) {
+	t.Helper()
+	saved := Stations
+	Stations = list
+	spatialOnce = sync.Once{}
+	t.Cleanup(func() {
+		Stations = saved
+		spatialOnce = sync.Once{}
+	})
+}
+
+func codes(found []Nearby) []string {
+	var cs []string
+	for _, n := range found {
+		cs = append(cs, n.Station.Code)
+	}
+	return cs
+}
+
+// bruteNear is Near without the grid: every located station, sorted.
+func bruteNear(lat, lon float64, n int) []Nearby {
+	var found []Nearby
+	for _, s := range Located() {
+		found = append(found, Nearby{Station: s, DistanceKm: Distance(lat, lon, s.Lat, s.Lon)})
+	}
+	slices.SortFunc(found, byDistance)
+	return found[:min(n, len(found))]
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name                   string
+		lat1, lon1, lat2, lon2 float64
+		want                   float64
+	}{
+		{"same point", 51.5, -0.1, 51.5, -0.1, 0},
+		{"one degree of latitude", 51, 0, 52, 0, 111.19},
+		{"one degree of longitude at the equator", 0, 0, 0, 1, 111.19},
+		{"Euston to Manchester Piccadilly", 51.5282, -0.1337, 53.4774, -2.2309, 259.06},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Distance(tt.lat1, tt.lon1, tt.lat2, tt.lon2); math.Abs(got-tt.want) > 0.1 {
+				t.Errorf("Distance = %.2f km, want %.2f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRingCells(t *testing.T) {
+	center := cell{510, 0}
+	for ring := range 4 {
+		cells := ringCells(center, ring)
+		want := max(1, 8*ring)
+		if len(cells) != want {
+			t.Errorf("ring %d has %d cells, want %d", ring, len(cells), want)
+		}
+		seen := make(map[cell]bool)
+		for _, c := range cells {
+			if seen[c] {
+				t.Errorf("ring %d lists %v twice", ring, c)
+			}
+			seen[c] = true
+			if d := max(abs(c.row-center.row), abs(c.col-center.col)); d != ring {
+				t.Errorf("ring %d lists %v, which is %d cells out", ring, c, d)
+			}
+		}
+	}
+}
+
+// TestRingDistanceIsLowerBound checks that nothing beyond a ring is closer
+// than ringDistance says, from points anywhere in the centre cell, including
+// its corners, to the near edges and corners of the cells past the ring.
+func TestRingDistanceIsLowerBound(t *testing.T) {
+	steps := []float64{0, 0.5, 0.9999}
+	for _, base := range []struct{ lat, lon float64 }{{50.0, -5.7}, {51.5, -0.2}, {54.3, -2.8}, {58.6, -3.6}} {
+		center := cellOf(base.lat, base.lon)
+		for ring := range 4 {
+			for _, fy := range steps {
+				for _, fx := range steps {
+					lat := (float64(center.row) + fy) * cellSize
+					lon := (float64(center.col) + fx) * cellSize
+					bound := ringDistance(lat, ring)
+					for _, c := range ringCells(center, ring+1) {
+						for _, cy := range steps {
+							for _, cx := range steps {
+								d := Distance(lat, lon, (float64(c.row)+cy)*cellSize, (float64(c.col)+cx)*cellSize)
+								if d < bound-1e-9 {
+									t.Fatalf("from (%.4f, %.4f), a point in %v is %.3f km away, closer than ring %d's bound of %.3f km",
+										lat, lon, c, d, ring, bound)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestRingDistance(t *testing.T) {
+	tests := []struct {
+		lat  float64
+		ring int
+		want float64
+	}{
+		{51.5, 0, 0},
+		{0, 1, 11.12}, // a tenth of a degree, nearly at the equator
+		{51.5, 1, 6.89},
+		{51.5, 2, 13.75},
+		{-51.5, 1, 6.89},
+	}
+	for _, tt := range tests {
+		if got := ringDistance(tt.lat, tt.ring); math.Abs(got-tt.want) > 0.01 {
+			t.Errorf("ringDistance(%v, %d) = %.3f, want %.2f", tt.lat, tt.ring, got, tt.want)
+		}
+	}
+}
+
+func TestNear(t *testing.T) {
+	withStations(t, []Station{
+		{Name: "Same Cell", Code: "AAA", Lat: 51.05, Lon: 0.02},
+		// From a point at the east edge of cell (510, 0), East is two rings
+		// out but closer than North, which is only one ring out
+		{Name: "North", Code: "NNN", Lat: 51.19, Lon: 0.0},
+		{Name: "East", Code: "EEE", Lat: 51.05, Lon: 0.2001},
+		{Name: "Far", Code: "FFF", Lat: 52.5, Lon: -1.5},
+		{Name: "Unlocated", Code: "UUU"},
+	})
+
+	tests := []struct {
+		name     string
+		lat, lon float64
+		n        int
+		want     []string
+	}{
+		{"nearest in the same cell", 51.05, 0.03, 1, []string{"AAA"}},
+		{"a closer station in the next ring", 51.05, 0.0999, 2, []string{"AAA", "EEE"}},
+		{"from the cell edge itself", 51.05, 0.1, 2, []string{"AAA", "EEE"}},
+		{"past the cell edge", 51.05, 0.12, 2, []string{"EEE", "AAA"}},
+		{"stations spanning several rings", 51.05, 0.0999, 4, []string{"AAA", "EEE", "NNN", "FFF"}},
+		{"more than there are", 51.05, 0.03, 10, []string{"AAA", "EEE", "NNN", "FFF"}},
+		{"far from any station", 55.0, -4.0, 1, []string{"FFF"}},
+		{"none asked for", 51.05, 0.03, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Near(tt.lat, tt.lon, tt.n)
+			if !slices.Equal(codes(got), tt.want) {
+				t.Errorf("Near = %v, want %v", codes(got), tt.want)
+			}
+			if !slices.Equal(codes(got), codes(bruteNear(tt.lat, tt.lon, tt.n))) {
+				t.Errorf("Near = %v, but the nearest are %v", codes(got), codes(bruteNear(tt.lat, tt.lon, tt.n)))
+			}
+		})
+	}
+}
+
+// TestNearMatchesBruteForce searches from points on and around cell edges,
+// where the grid search must not stop a ring too early.
+func TestNearMatchesBruteForce(t *testing.T) {
+	for lat := 50.0; lat <= 58.0; lat += 0.35 {
+		for lon := -5.0; lon <= 1.5; lon += 0.35 {
+			for _, p := range [][2]float64{
+				{lat, lon},
+				{math.Round(lat*10) / 10, lon},
+				{lat, math.Round(lon*10) / 10},
+				{math.Round(lat*10)/10 - 1e-9, math.Round(lon*10)/10 - 1e-9},
+			} {
+				for _, n := range []int{1, 3, 10} {
+					got, want := codes(Near(p[0], p[1], n)), codes(bruteNear(p[0], p[1], n))
+					if !slices.Equal(got, want) {
+						t.Fatalf("Near(%v, %v, %d) = %v, want %v", p[0], p[1], n, got, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestWithin(t *testing.T) {
+	withStations(t, []Station{
+		{Name: "Close", Code: "CCC", Lat: 51.5, Lon: -0.11},
+		{Name: "Edge", Code: "EDG", Lat: 51.5, Lon: -0.2},
+		{Name: "Outside", Code: "OUT", Lat: 51.7, Lon: -0.1},
+		{Name: "Unlocated", Code: "UUU"},
+	})
+	edge := Distance(51.5, -0.1, 51.5, -0.2)
+
+	tests := []struct {
+		name   string
+		radius float64
+		want   []string
+	}{
+		{"nothing in range", 0.5, nil},
+		{"nearest first", 20, []string{"CCC", "EDG"}},
+		{"exactly at the radius", edge, []string{"CCC", "EDG"}},
+		{"just short of it", edge - 0.001, []string{"CCC"}},
+		{"everything located", 100, []string{"CCC", "EDG", "OUT"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Within(51.5, -0.1, tt.radius)
+			if !slices.Equal(codes(got), tt.want) {
+				t.Errorf("Within = %v, want %v", codes(got), tt.want)
+			}
+			for _, n := range got {
+				if n.DistanceKm > tt.radius {
+					t.Errorf("%s is %.3f km away, beyond %.3f", n.Station.Code, n.DistanceKm, tt.radius)
+				}
+			}
+		})
+	}
+}
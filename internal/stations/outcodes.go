@@ -0,0 +1,58 @@
+package stations
+
+// outcodes maps postcode outcodes, the part before the space, to the
+// approximate centre of the area they cover. go generate replaces it with
+// every outcode in the ONS Postcode Directory (see gen); until then it is a
+// small subset: the city-centre outcodes around the main stations, to within
+// a kilometre or so.
+var outcodes = map[string][2]float64{
+	// Central London
+	"EC1A": {51.5190, -0.0990},
+	"EC1M": {51.5205, -0.1015},
+	"EC1V": {51.5270, -0.0980},
+	"EC2A": {51.5230, -0.0820},
+	"EC2M": {51.5180, -0.0810},
+	"EC2V": {51.5150, -0.0930},
+	"EC3M": {51.5120, -0.0800},
+	"EC3N": {51.5110, -0.0760},
+	"EC4M": {51.5140, -0.1010},
+	"EC4N": {51.5120, -0.0900},
+	"WC1H": {51.5260, -0.1280},
+	"WC1X": {51.5270, -0.1170},
+	"WC2H": {51.5140, -0.1280},
+	"WC2N": {51.5080, -0.1250},
+	"N1":   {51.5380, -0.0970},
+	"N1C":  {51.5350, -0.1250},
+	"NW1":  {51.5320, -0.1420},
+	"W2":   {51.5140, -0.1810},
+	"SW1A": {51.5030, -0.1350},
+	"SW1V": {51.4900, -0.1400},
+	"SE1":  {51.4980, -0.0900},
+	"E1":   {51.5170, -0.0590},
+	"E14":  {51.5030, -0.0180},
+
+	// Other cities
+	"M1":   {53.4780, -2.2360},
+	"M2":   {53.4800, -2.2440},
+	"M3":   {53.4840, -2.2530},
+	"M4":   {53.4840, -2.2260},
+	"B1":   {52.4800, -1.9060},
+	"B2":   {52.4790, -1.8970},
+	"B5":   {52.4720, -1.8910},
+	"LS1":  {53.7970, -1.5480},
+	"G1":   {55.8600, -4.2500},
+	"G2":   {55.8620, -4.2600},
+	"EH1":  {55.9510, -3.1890},
+	"EH2":  {55.9540, -3.1970},
+	"BS1":  {51.4520, -2.5920},
+	"L1":   {53.4030, -2.9790},
+	"L3":   {53.4100, -2.9850},
+	"CF10": {51.4760, -3.1770},
+	"YO1":  {53.9590, -1.0820},
+	"NE1":  {54.9720, -1.6130},
+	"RG1":  {51.4550, -0.9700},
+	"CB1":  {52.1980, 0.1360},
+	"OX1":  {51.7510, -1.2580},
+	"S1":   {53.3800, -1.4680},
+	"NG1":  {52.9530, -1.1480},
+}
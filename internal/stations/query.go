@@ -0,0 +1,40 @@
+package stations
+
+import "strings"
+
+// Filter returns the stations for which keep returns true, in the
+// order of Stations.
+func Filter(keep func(Station) bool) []Station {
+	var matches []Station
+	for _, s := range Stations {
+		if keep(s) {
+			matches = append(matches, s)
+		}
+	}
+	return matches
+}
+
+// ByTIPLOC returns the station with the given TIPLOC, ignoring case.
+func ByTIPLOC(tiploc string) (Station, bool) {
+	i, ok := index.byTIPLOC[strings.ToUpper(strings.TrimSpace(tiploc))]
+	if !ok {
+		return Station{}, false
+	}
+	return Stations[i], true
+}
+
+// InCountry returns the stations known to be in country.
+func InCountry(country Country) []Station {
+	return Filter(func(s Station) bool { return s.Country == country })
+}
+
+// Located returns the stations whose coordinates are known.
+func Located() []Station {
+	return Filter(Station.HasLocation)
+}
+
+// Coverage returns how many stations have known coordinates, out of all of
+// them. Searches by location, such as Near, only find the located ones.
+func Coverage() (located, total int) {
+	return len(Located()), len(Stations)
+}
@@ -0,0 +1,94 @@
+package stations
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnknown is returned by Resolve when nothing matches the query.
+var ErrUnknown = errors.New("unknown station")
+
+// maxSuggestions is how many stations an AmbiguousError offers.
+const maxSuggestions = 8
+
+// AmbiguousError is returned by Resolve when a query matches several stations
+// about as well as each other. Matches holds the best of them, best first.
+type AmbiguousError struct {
+	Query   string
+	Matches []Match
+}
+
+func (e *AmbiguousError) Error() string {
+	return fmt.Sprintf("%q matches more than one station", e.Query)
+}
+
+// aliases maps the short names people use for cities and London termini to
+// the station they most likely mean. Keys are normalised.
+var aliases = map[string]string{
+	"bham":             "BHM",
+	"birmingham":       "BHM",
+	"bristol":          "BRI",
+	"brighton":         "BTN",
+	"cannon street":    "CST",
+	"cardiff":          "CDF",
+	"charing cross":    "CHX",
+	"euston":           "EUS",
+	"exeter":           "EXD",
+	"fenchurch street": "FST",
+	"gatwick":          "GTW",
+	"glasgow":          "GLC",
+	"kings cross":      "KGX",
+	"kings x":          "KGX",
+	"liverpool":        "LIV",
+	"liverpool street": "LST",
+	"manchester":       "MAN",
+	"marylebone":       "MYB",
+	"newport":          "NWP",
+	"paddington":       "PAD",
+	"preston":          "PRE",
+	"southampton":      "SOU",
+	"st pancras":       "STP",
+	"stansted":         "SSD",
+	"swindon":          "SWI",
+	"victoria":         "VIC",
+	"waterloo":         "WAT",
+}
+
+// Resolve finds the station a user means by query, which may be a CRS code, a
+// common short name such as "Manchester", a full name, or a fuzzy query such
+// as "kings x". It returns an error wrapping ErrUnknown if nothing matches,
+// and an *AmbiguousError if several stations match equally well.
+func Resolve(query string) (Station, error) {
+	if s, ok := ByCode(query); ok {
+		return s, nil
+	}
+	if code, ok := aliases[Normalize(query)]; ok {
+		if s, ok := ByCode(code); ok {
+			return s, nil
+		}
+	}
+	if s, ok := ByName(query); ok {
+		return s, nil
+	}
+
+	matches := Search(query)
+	switch {
+	case len(matches) == 0:
+		return Station{}, fmt.Errorf("%w %q", ErrUnknown, query)
+	case len(matches) == 1:
+		return matches[0].Station, nil
+	}
+
+	// A query that matches the start of a name, or of one of its words, picks
+	// that station if no other matches as closely
+	best, next := matches[0].tier(), matches[1].tier()
+	if best >= scoreWord && best > next {
+		return matches[0].Station, nil
+	}
+	return Station{}, &AmbiguousError{Query: query, Matches: matches[:min(len(matches), maxSuggestions)]}
+}
+
+// tier returns how the match was made: one of the score constants.
+func (m Match) tier() int {
+	return m.Score / scoreTier * scoreTier
+}
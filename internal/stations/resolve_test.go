@@ -0,0 +1,89 @@
+package stations
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		// Codes come first, even where a name or alias would match
+		{"MAN", "MAN"},
+		{"dly", "DLY"},
+		{"g:lon", "G:LON"},
+		// Aliases for cities and London termini
+		{"Manchester", "MAN"},
+		{"birmingham", "BHM"},
+		{"glasgow", "GLC"},
+		{"Kings X", "KGX"},
+		{"euston", "EUS"},
+		{"Newport", "NWP"},
+		// Full names, and group names with or without "(any)"
+		{"Abergele & Pensarn", "AGL"},
+		{"abererch", "ABH"},
+		{"london", "G:LON"},
+		{"Manchester (any)", "G:MAN"},
+		// The only match, or the only one at the start of a word
+		{"picc", "MAN"},
+		{"kngs crss", "KGX"},
+		{"york", "YRK"},
+	}
+	for _, tt := range tests {
+		s, err := Resolve(tt.query)
+		if err != nil || s.Code != tt.want {
+			t.Errorf("Resolve(%q) = %s, %v, want %s", tt.query, s.Code, err, tt.want)
+		}
+	}
+}
+
+func TestResolveAmbiguous(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []string // the best candidates, in order
+	}{
+		{"st albans", []string{"SAC", "SAA"}},
+		{"manc", []string{"G:MAN", "MIA", "MCV", "MAN"}},
+		{"lon", []string{"LGN"}},
+	}
+	for _, tt := range tests {
+		_, err := Resolve(tt.query)
+		var ambiguous *AmbiguousError
+		if !errors.As(err, &ambiguous) {
+			t.Errorf("Resolve(%q) error = %v, want an AmbiguousError", tt.query, err)
+			continue
+		}
+		if ambiguous.Query != tt.query {
+			t.Errorf("Resolve(%q) Query = %q", tt.query, ambiguous.Query)
+		}
+		if len(ambiguous.Matches) < len(tt.want) || len(ambiguous.Matches) > maxSuggestions {
+			t.Errorf("Resolve(%q) offers %d candidates, want %d to %d", tt.query, len(ambiguous.Matches), len(tt.want), maxSuggestions)
+		}
+		if got := matchCodes(ambiguous.Matches, len(tt.want)); !slices.Equal(got, tt.want) {
+			t.Errorf("Resolve(%q) candidates = %v, want %v first", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestResolveUnknown(t *testing.T) {
+	for _, query := range []string{"qqqq", "", "   "} {
+		_, err := Resolve(query)
+		if !errors.Is(err, ErrUnknown) {
+			t.Errorf("Resolve(%q) error = %v, want ErrUnknown", query, err)
+		}
+	}
+}
+
+func TestAliasesAreKnown(t *testing.T) {
+	for alias, code := range aliases {
+		if _, ok := ByCode(code); !ok {
+			t.Errorf("alias %q is for unknown station %s", alias, code)
+		}
+		if alias != Normalize(alias) {
+			t.Errorf("alias %q is not normalised", alias)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package stations
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Match is a station found by Search.
+type Match struct {
+	Station Station
+	Score   int   // higher is a better match
+	Matched []int // positions of the runes of Station.Name that matched, for highlighting
+}
+
+// Scores for how a query matches a station, best first. Matches in the same
+// tier are ranked by the fuzzy score, which is always below scoreTier.
+const (
+	scoreCode      = 6 * scoreTier // the query is the station's CRS code
+	scoreName      = 5 * scoreTier // the whole name
+	scorePrefix    = 4 * scoreTier // the start of the name
+	scoreWord      = 3 * scoreTier // the start of a word in the name
+	scoreSubstring = 2 * scoreTier // somewhere in the name
+	scoreFuzzy     = 1 * scoreTier // its letters appear in order in the name
+	scoreTier      = 10000
+)
+
+// Search returns the stations and groups that match query, best match first.
+// A station matches if the query is its code, or if the query's letters
+// appear in its name in order; it ranks higher the closer the query is to the
+// whole name, its start, or the start of one of its words.
+func Search(query string) []Match {
+	q := Normalize(query)
+	if q == "" {
+		return nil
+	}
+	code := strings.ToUpper(strings.TrimSpace(query))
+
+	var matches []Match
+	for _, g := range groups {
+		if m, ok := match(g.Station(), g.normalizedName(), q, code); ok {
+			matches = append(matches, m)
+		}
+	}
+	for i, s := range Stations {
+		if m, ok := match(s, index.normalized[i], q, code); ok {
+			matches = append(matches, m)
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b Match) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Station.Name, b.Station.Name)
+	})
+	return matches
+}
+
+// match scores one station against a normalised query.
+func match(s Station, name, q, code string) (Match, bool) {
+	matched, fuzzy, ok := fuzzyMatch(s.Name, q)
+
+	var tier int
+	switch {
+	case s.Code == code:
+		tier = scoreCode
+	case name == q:
+		tier = scoreName
+	case strings.HasPrefix(name, q):
+		tier = scorePrefix
+	case strings.Contains(" "+name, " "+q):
+		tier = scoreWord
+	case strings.Contains(name, q):
+		tier = scoreSubstring
+	case ok:
+		tier = scoreFuzzy
+	default:
+		return Match{}, false
+	}
+	return Match{Station: s, Score: tier + fuzzy, Matched: matched}, true
+}
+
+// fuzzyMatch looks for the letters and digits of q, in order, in name. It
+// prefers letters at the start of a word or straight after the previous
+// match, and returns where they matched and a score out of scoreTier that
+// favours contiguous runs, word starts and short names.
+func fuzzyMatch(name, q string) (matched []int, score int, ok bool) {
+	runes := []rune(strings.ToLower(name))
+	var want []rune
+	for _, r := range q {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			want = append(want, r)
+		}
+	}
+	if len(want) == 0 {
+		return nil, 0, false
+	}
+
+	score = scoreTier / 2
+	pos := 0
+	for _, r := range want {
+		found := -1
+		for i := pos; i < len(runes); i++ {
+			if runes[i] != r {
+				continue
+			}
+			if found < 0 {
+				found = i
+			}
+			// A match right after the previous one, or at a word start, beats
+			// the first one found
+			if (len(matched) > 0 && i == matched[len(matched)-1]+1) || wordStart(runes, i) {
+				found = i
+				break
+			}
+		}
+		if found < 0 {
+			return nil, 0, false
+		}
+
+		switch {
+		case len(matched) > 0 && found == matched[len(matched)-1]+1:
+			score += 30
+		case wordStart(runes, found):
+			score += 20
+		default:
+			score -= found - pos // letters skipped over
+		}
+		matched = append(matched, found)
+		pos = found + 1
+	}
+	score -= len(runes) - len(want) // shorter names are closer matches
+	return matched, min(max(score, 0), scoreTier-1), true
+}
+
+func wordStart(runes []rune, i int) bool {
+	return i == 0 || !unicode.IsLetter(runes[i-1]) && !unicode.IsDigit(runes[i-1])
+}
@@ -0,0 +1,179 @@
+package stations
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func stationCodes(list []Station) []string {
+	var cs []string
+	for _, s := range list {
+		cs = append(cs, s.Code)
+	}
+	return cs
+}
+
+func matchCodes(matches []Match, n int) []string {
+	var cs []string
+	for _, m := range matches[:min(n, len(matches))] {
+		cs = append(cs, m.Station.Code)
+	}
+	return cs
+}
+
+func TestNamesAreTidied(t *testing.T) {
+	for _, s := range Stations {
+		if s.Name != strings.Join(strings.Fields(s.Name), " ") {
+			t.Errorf("%s name %q has stray spaces", s.Code, s.Name)
+		}
+	}
+}
+
+func TestByCode(t *testing.T) {
+	tests := []struct {
+		code     string
+		wantCode string
+		wantName string
+	}{
+		{"MAN", "MAN", "Manchester Piccadilly"},
+		{" man ", "MAN", "Manchester Piccadilly"},
+		{"ABH", "ABH", "Abererch"}, // listed as "Abererch " in data.go
+		{"g:man", "G:MAN", "Manchester (any)"},
+		{"XYZ", "", ""},
+		{"", "", ""},
+	}
+	for _, tt := range tests {
+		s, ok := ByCode(tt.code)
+		if ok != (tt.wantCode != "") || s.Code != tt.wantCode || s.Name != tt.wantName {
+			t.Errorf("ByCode(%q) = %s %q, %v, want %s %q", tt.code, s.Code, s.Name, ok, tt.wantCode, tt.wantName)
+		}
+	}
+}
+
+func TestByName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Abererch", "ABH"},
+		{"abererch ", "ABH"},
+		{"Abergele & Pensarn", "AGL"},
+		{"abergele and pensarn", "AGL"},
+		{"London (any)", "G:LON"},
+		{"london", "G:LON"},
+		{"Manchester Picc", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		s, ok := ByName(tt.name)
+		if ok != (tt.want != "") || s.Code != tt.want {
+			t.Errorf("ByName(%q) = %s, %v, want %s", tt.name, s.Code, ok, tt.want)
+		}
+	}
+}
+
+func TestWithPrefix(t *testing.T) {
+	tests := []struct {
+		prefix string
+		want   []string
+	}{
+		{"aber", []string{"ABE", "ACY", "ABA", "ABD", "AUR", "AVY", "ABH", "AGV", "AGL", "AYW"}},
+		{"Abererch ", []string{"ABH"}},
+		{"st al", []string{"SAA", "SAC"}},
+		{"London K", []string{"KGX"}},
+		{"zzz", nil},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if got := stationCodes(WithPrefix(tt.prefix)); !slices.Equal(got, tt.want) {
+			t.Errorf("WithPrefix(%q) = %v, want %v", tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestMatchTiers(t *testing.T) {
+	s := Station{Name: "Chester-le-Street", Code: "CLS"}
+	tests := []struct {
+		query string
+		tier  int
+	}{
+		{"cls", scoreCode},
+		{"chester le street", scoreName},
+		{"chester", scorePrefix},
+		{"street", scoreWord},
+		{"treet", scoreSubstring},
+		{"chstr", scoreFuzzy},
+		{"xyz", 0},
+	}
+	for _, tt := range tests {
+		m, ok := match(s, Normalize(s.Name), Normalize(tt.query), strings.ToUpper(tt.query))
+		if ok != (tt.tier != 0) || m.tier() != tt.tier {
+			t.Errorf("match(%q) tier = %d, %v, want %d", tt.query, m.tier(), ok, tt.tier)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []string // the best matches, in order
+	}{
+		// A code beats every name match, then shorter names come first
+		{"MAN", []string{"MAN", "MNE", "MAS"}},
+		{"man", []string{"MAN", "MNE", "MAS"}},
+		// The whole name, then names starting with it, then a word; ties go by name
+		{"chester", []string{"CTR", "CRD", "CHD", "CLS", "GRC"}},
+		{"ford", []string{"FOD", "CFR"}},
+		{"Abererch ", []string{"ABH"}},
+		{"picc", []string{"MAN"}},
+		// A group ranks with the stations in it, ahead of them
+		{"london", []string{"G:LON"}},
+		{"glasgow", []string{"G:GLA", "GLC", "GLQ"}},
+		// Letters in order, skipping some
+		{"kngs crss", []string{"KGX"}},
+		{"dly", []string{"DLY"}},
+		{"", nil},
+		{"qqqq", nil},
+	}
+	for _, tt := range tests {
+		if got := matchCodes(Search(tt.query), len(tt.want)); !slices.Equal(got, tt.want) {
+			t.Errorf("Search(%q) = %v, want %v first", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestSearchOrder(t *testing.T) {
+	matches := Search("man")
+	if len(matches) < 10 {
+		t.Fatalf("got %d matches for man, want many", len(matches))
+	}
+	for i := 1; i < len(matches); i++ {
+		a, b := matches[i-1], matches[i]
+		if a.Score < b.Score || a.Score == b.Score && a.Station.Name > b.Station.Name {
+			t.Errorf("%s (%d) ranked before %s (%d)", a.Station.Code, a.Score, b.Station.Code, b.Score)
+		}
+	}
+}
+
+func TestSearchMatched(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []int
+	}{
+		{"picc", []int{11, 12, 13, 14}},              // "Manchester Piccadilly"
+		{"mcr pic", []int{0, 3, 9, 11, 12, 13}},      // letters at word starts
+		{"Abererch ", []int{0, 1, 2, 3, 4, 5, 6, 7}}, // not the trailing space
+	}
+	for _, tt := range tests {
+		matches := Search(tt.query)
+		i := slices.IndexFunc(matches, func(m Match) bool { return m.Station.Code == "MAN" || m.Station.Code == "ABH" })
+		if i < 0 {
+			t.Errorf("Search(%q) found neither MAN nor ABH", tt.query)
+			continue
+		}
+		if got := matches[i].Matched; !slices.Equal(got, tt.want) {
+			t.Errorf("Search(%q) matched %s at %v, want %v", tt.query, matches[i].Station.Name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,353 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/table"
+)
+
+// boardRefreshInterval is how often a station board reloads, like a real screen.
+const boardRefreshInterval = time.Minute
+
+// BoardModel shows a station departures or arrivals board.
+type BoardModel struct {
+	code       string
+	name       string
+	direction  api.Direction
+	apiClient  *api.Client
+	entries    []api.BoardEntry
+	skipped    []api.ServiceError
+	showSkip   bool
+	req        requestScope
+	cursor     int
+	viewport   viewport.Model
+	ready      bool
+	service    *serviceView
+	spinner    spinner.Model
+	loading    bool
+	refreshing bool
+	now        time.Time
+	width      int
+	height     int
+	err        error
+}
+
+type boardLoadedMsg struct {
+	result api.BoardResult
+	err    error
+}
+
+func NewBoardModel(apiClient *api.Client, code, name string, direction api.Direction) BoardModel {
+	s := spinner.New(
+		spinner.WithSpinner(spinner.Points),
+		spinner.WithStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("205"))),
+	)
+	m := BoardModel{
+		code:      code,
+		name:      name,
+		direction: direction,
+		apiClient: apiClient,
+		spinner:   s,
+		loading:   true,
+		now:       time.Now(),
+	}
+	m.req.next()
+	return m
+}
+
+func (m BoardModel) Init() tea.Cmd {
+	return tea.Batch(tea.RequestBackgroundColor, m.spinner.Tick, m.fetchBoard(m.req.ctx),
+		clockTick(), watchTick(boardRefreshInterval))
+}
+
+func (m BoardModel) fetchBoard(ctx context.Context) tea.Cmd {
+	return func() tea.Msg {
+		result, err := m.apiClient.GetBoard(ctx, m.code, m.direction)
+		return boardLoadedMsg{result: result, err: err}
+	}
+}
+
+func (m BoardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.BackgroundColorMsg:
+		SetDarkMode(msg.IsDark())
+		m.refresh()
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		headerHeight := 3 // title + blank line
+		footerHeight := 3 // blank line + footer + blank line
+		if !m.ready {
+			m.viewport = viewport.New(viewport.WithWidth(msg.Width), viewport.WithHeight(msg.Height-headerHeight-footerHeight))
+			m.ready = true
+		} else {
+			m.viewport.SetWidth(msg.Width)
+			m.viewport.SetHeight(msg.Height - headerHeight - footerHeight)
+		}
+		m.refresh()
+		if m.service != nil {
+			m.service.SetSize(msg.Width, msg.Height)
+		}
+		return m, nil
+
+	case boardLoadedMsg:
+		if isCancelled(msg.err) {
+			return m, nil
+		}
+		m.loading = false
+		m.refreshing = false
+		if msg.err != nil && m.entries != nil {
+			// Keep showing the last board rather than blanking the screen
+			return m, nil
+		}
+		m.err = msg.err
+		m.entries = msg.result.Entries
+		m.skipped = msg.result.Skipped
+		if len(m.skipped) == 0 {
+			m.showSkip = false
+		}
+		m.refresh()
+		return m, nil
+
+	case clockTickMsg:
+		m.now = time.Time(msg)
+		return m, clockTick()
+
+	case watchTickMsg:
+		return m, tea.Batch(watchTick(boardRefreshInterval), requestRefresh)
+
+	case refreshRequestMsg:
+		if m.loading || m.refreshing {
+			return m, nil
+		}
+		m.refreshing = true
+		return m, m.fetchBoard(m.req.next())
+
+	case openServiceMsg:
+		sv := newServiceView(m.apiClient, msg.serviceID, msg.label, m.width, m.height)
+		m.service = &sv
+		return m, sv.Init()
+
+	case closeServiceMsg:
+		m.service = nil
+		return m, nil
+
+	case tea.KeyPressMsg:
+		if msg.String() == "ctrl+c" {
+			m.req.stop()
+			return m, tea.Quit
+		}
+		if m.service == nil {
+			switch msg.String() {
+			case "q", "esc":
+				m.req.stop()
+				return m, tea.Quit
+			case "r":
+				return m, requestRefresh
+			case "e":
+				if len(m.skipped) > 0 {
+					m.showSkip = !m.showSkip
+					m.refresh()
+					if m.showSkip {
+						m.viewport.GotoBottom()
+					}
+				}
+				return m, nil
+			case "down", "j":
+				m.cursor++
+				m.refresh()
+				return m, nil
+			case "up", "k":
+				m.cursor--
+				m.refresh()
+				return m, nil
+			case "enter":
+				if m.cursor < len(m.entries) {
+					entry := m.entries[m.cursor]
+					label := fmt.Sprintf("%s %s", formatClock(entry.ScheduledTime), entry.Destination)
+					return m, func() tea.Msg { return openServiceMsg{serviceID: entry.ServiceID, label: label} }
+				}
+				return m, nil
+			}
+		}
+	}
+
+	if m.service != nil {
+		sv, cmd := m.service.Update(msg)
+		m.service = &sv
+		return m, cmd
+	}
+
+	var cmd tea.Cmd
+	if m.loading {
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+	}
+	if m.ready {
+		m.viewport, cmd = m.viewport.Update(msg)
+	}
+	return m, cmd
+}
+
+// refresh re-renders the board and keeps the cursor row on screen.
+func (m *BoardModel) refresh() {
+	if !m.ready {
+		return
+	}
+	m.cursor = max(0, min(m.cursor, len(m.entries)-1))
+	content := m.renderBoard()
+	if m.showSkip {
+		content += renderSkippedNotes(m.skipped)
+	}
+	m.viewport.SetContent(content)
+	if m.cursor == 0 {
+		m.viewport.GotoTop()
+	} else {
+		m.viewport.EnsureVisible(m.cursor+tableHeaderLines, 0, 0)
+	}
+}
+
+func (m BoardModel) View() tea.View {
+	v := tea.NewView("")
+	theme := CurrentTheme()
+
+	switch {
+	case m.loading:
+		style := lipgloss.NewStyle().
+			Foreground(theme.Title).
+			Bold(true).
+			Padding(1, 0)
+		v = tea.NewView(style.Render(fmt.Sprintf("%s Loading %s for %s...",
+			m.spinner.View(), m.direction, m.name)) + renderThrottle(m.apiClient.LimiterStatus()))
+
+	case m.err != nil:
+		errorStyle := lipgloss.NewStyle().
+			Foreground(theme.Error).
+			Bold(true).
+			Padding(1, 0)
+		v = tea.NewView(errorStyle.Render(fmt.Sprintf("%s\n\nPress q to quit", errorMessage(m.err))))
+
+	case m.service != nil:
+		v = tea.NewView(m.service.View())
+
+	case len(m.entries) == 0:
+		emptyStyle := lipgloss.NewStyle().
+			Foreground(theme.Muted).
+			Padding(1, 0)
+		msg := fmt.Sprintf("No %s in the next two hours.", m.direction)
+		if len(m.skipped) > 0 {
+			msg += "\n\n" + skippedSummary(len(m.skipped)) + ":" + renderSkippedNotes(m.skipped)
+		}
+		v = tea.NewView(emptyStyle.Render(msg + "\n\nPress r to refresh or q to quit"))
+
+	default:
+		titleStyle := lipgloss.NewStyle().Foreground(theme.Leaving).Bold(true)
+		clockStyle := lipgloss.NewStyle().Foreground(theme.Time).Bold(true)
+		title := titleStyle.Render(fmt.Sprintf("%s  %s", strings.ToUpper(m.direction.String()), m.name)) +
+			"  " + clockStyle.Render(m.now.Format("15:04:05"))
+
+		footerStyle := lipgloss.NewStyle().Foreground(theme.Muted)
+		status := ""
+		if m.refreshing {
+			status = " • refreshing..."
+		}
+		footer := footerStyle.Render(fmt.Sprintf("↑/↓ select • enter calling points • r refresh • q to quit%s", status))
+		if len(m.skipped) > 0 {
+			footer += "\n" + lipgloss.NewStyle().Foreground(theme.Late).Render(skippedSummary(len(m.skipped)) + " (press e for details)")
+		}
+
+		v = tea.NewView(title + "\n\n" + m.viewport.View() + "\n\n" + footer + "\n")
+	}
+
+	v.AltScreen = true
+	return v
+}
+
+func (m BoardModel) renderBoard() string {
+	theme := CurrentTheme()
+
+	headers := []string{"Time", "Destination", "Origin", "Plat", "Expected", "Operator"}
+	if m.direction == api.Arrivals {
+		headers = []string{"Time", "Origin", "Destination", "Plat", "Expected", "Operator"}
+	}
+
+	rows := [][]string{}
+	for _, entry := range m.entries {
+		primary, secondary := entry.Destination, entry.Origin
+		if m.direction == api.Arrivals {
+			primary, secondary = entry.Origin, entry.Destination
+		}
+		rows = append(rows, []string{
+			formatClock(entry.ScheduledTime),
+			truncate(primary, 28),
+			truncate(secondary, 24),
+			entry.Platform,
+			boardStatus(entry),
+			truncate(entry.Operator, 20),
+		})
+	}
+
+	t := table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(theme.Border)).
+		Headers(headers...).
+		Rows(rows...).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			base := lipgloss.NewStyle().Align(lipgloss.Left)
+			if row == -1 {
+				return base.Foreground(theme.Muted).Bold(true)
+			}
+			if row == m.cursor {
+				base = base.Background(theme.Selected)
+			}
+			if m.entries[row].Cancelled {
+				if col == 4 {
+					return base.Foreground(theme.Error).Bold(true)
+				}
+				return base.Foreground(theme.Muted).Strikethrough(true)
+			}
+			switch col {
+			case 0: // Time
+				return base.Foreground(theme.Time).Bold(true)
+			case 1: // Destination or origin
+				return base.Foreground(theme.Leaving).Bold(true)
+			case 3: // Platform
+				return base.Foreground(theme.DepPlatform).Bold(true)
+			case 4: // Expected
+				if rows[row][4] == "On time" {
+					return base.Foreground(theme.OnTime)
+				}
+				return base.Foreground(theme.Late)
+			case 5: // Operator
+				return base.Foreground(theme.Service)
+			default:
+				return base.Foreground(theme.Leaving)
+			}
+		})
+
+	return t.String()
+}
+
+// boardStatus describes a board entry the way station screens do, e.g.
+// "On time", "Exp 14:37" or "Cancelled".
+func boardStatus(entry api.BoardEntry) string {
+	switch {
+	case entry.Cancelled:
+		return "Cancelled"
+	case entry.ExpectedTime.IsZero():
+		return ""
+	case entry.LateMinutes == 0:
+		return "On time"
+	}
+	return "Exp " + formatClock(entry.ExpectedTime)
+}
@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+	"charm.land/lipgloss/v2"
+)
+
+// errorMessage explains an error from the API client in terms the user can act on.
+func errorMessage(err error) string {
+	var statusErr *api.StatusError
+	var netErr net.Error
+	switch {
+	case errors.Is(err, api.ErrUnauthorized):
+		return "Your token was rejected — run rtt-cli --reset to enter a new one."
+	case errors.Is(err, api.ErrRateLimited):
+		return "Realtime Trains is limiting requests right now. Wait a minute and try again."
+	case errors.Is(err, api.ErrNotFound):
+		return "Realtime Trains has no record of that. It may have been removed from the timetable."
+	case errors.As(err, &statusErr):
+		return fmt.Sprintf("Realtime Trains is having problems (status %d). Try again later.", statusErr.StatusCode)
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return "Realtime Trains took too long to respond. Check your connection and try again."
+	}
+	return fmt.Sprintf("Error: %v", err)
+}
+
+// renderThrottle tells the user a request is waiting out a rate limit, so a
+// long search doesn't look stuck. It returns "" when nothing is throttled.
+func renderThrottle(status api.LimiterStatus) string {
+	notice := throttleNotice(status)
+	if notice == "" {
+		return ""
+	}
+	return "\n" + notice
+}
+
+// throttleNotice is renderThrottle without the line break, for status lines.
+func throttleNotice(status api.LimiterStatus) string {
+	wait := status.RetryIn(time.Now())
+	if wait == 0 {
+		return ""
+	}
+	return lipgloss.NewStyle().Foreground(CurrentTheme().Muted).
+		Render(fmt.Sprintf("Throttled by Realtime Trains, retrying in %s", wait))
+}
@@ -0,0 +1,232 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/baz-sh/rtt-cli/internal/journey"
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// JourneyModel shows itineraries with changes between two stations, one block
+// per itinerary listing each leg and the wait at every interchange.
+type JourneyModel struct {
+	planner     *journey.Planner
+	fromCode    string
+	toCode      string
+	fromName    string
+	toName      string
+	opts        journey.Options
+	itineraries []journey.Itinerary
+	req         requestScope
+	viewport    viewport.Model
+	ready       bool
+	spinner     spinner.Model
+	loading     bool
+	err         error
+}
+
+type journeyPlannedMsg struct {
+	itineraries []journey.Itinerary
+	err         error
+}
+
+func NewJourneyModel(planner *journey.Planner, fromCode, toCode, fromName, toName string, opts journey.Options) JourneyModel {
+	s := spinner.New(
+		spinner.WithSpinner(spinner.Points),
+		spinner.WithStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("205"))),
+	)
+	m := JourneyModel{
+		planner:  planner,
+		fromCode: fromCode,
+		toCode:   toCode,
+		fromName: fromName,
+		toName:   toName,
+		opts:     opts,
+		spinner:  s,
+		loading:  true,
+	}
+	m.req.next()
+	return m
+}
+
+func (m JourneyModel) Init() tea.Cmd {
+	return tea.Batch(tea.RequestBackgroundColor, m.spinner.Tick, m.plan(m.req.ctx))
+}
+
+func (m JourneyModel) plan(ctx context.Context) tea.Cmd {
+	return func() tea.Msg {
+		itineraries, err := m.planner.Plan(ctx, m.fromCode, m.toCode, m.opts)
+		return journeyPlannedMsg{itineraries: itineraries, err: err}
+	}
+}
+
+func (m JourneyModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.BackgroundColorMsg:
+		SetDarkMode(msg.IsDark())
+		m.refresh()
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		headerHeight := 3 // title + blank line
+		footerHeight := 3 // blank line + footer + blank line
+		if !m.ready {
+			m.viewport = viewport.New(viewport.WithWidth(msg.Width), viewport.WithHeight(msg.Height-headerHeight-footerHeight))
+			m.ready = true
+		} else {
+			m.viewport.SetWidth(msg.Width)
+			m.viewport.SetHeight(msg.Height - headerHeight - footerHeight)
+		}
+		m.refresh()
+		return m, nil
+
+	case journeyPlannedMsg:
+		m.loading = false
+		m.itineraries = msg.itineraries
+		m.err = msg.err
+		m.refresh()
+		return m, nil
+
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "ctrl+c", "q", "esc":
+			m.req.stop()
+			return m, tea.Quit
+		case "j":
+			m.viewport.ScrollDown(1)
+			return m, nil
+		case "k":
+			m.viewport.ScrollUp(1)
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	if m.loading {
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+	}
+	if m.ready {
+		m.viewport, cmd = m.viewport.Update(msg)
+	}
+	return m, cmd
+}
+
+func (m *JourneyModel) refresh() {
+	if !m.ready {
+		return
+	}
+	m.viewport.SetContent(m.renderItineraries())
+}
+
+func (m JourneyModel) View() tea.View {
+	v := tea.NewView("")
+	theme := CurrentTheme()
+
+	switch {
+	case m.loading:
+		style := lipgloss.NewStyle().
+			Foreground(theme.Title).
+			Bold(true).
+			Padding(1, 0)
+		v = tea.NewView(style.Render(fmt.Sprintf("%s Planning journeys from %s to %s...",
+			m.spinner.View(), m.fromName, m.toName)) + renderThrottle(m.planner.LimiterStatus()))
+
+	case m.err != nil:
+		errorStyle := lipgloss.NewStyle().
+			Foreground(theme.Error).
+			Bold(true).
+			Padding(1, 0)
+		v = tea.NewView(errorStyle.Render(fmt.Sprintf("%s\n\nPress q to quit", errorMessage(m.err))))
+
+	case len(m.itineraries) == 0:
+		emptyStyle := lipgloss.NewStyle().
+			Foreground(theme.Muted).
+			Padding(1, 0)
+		v = tea.NewView(emptyStyle.Render(fmt.Sprintf("No journeys with up to %d changes found in the next two hours.\n\nPress q to quit",
+			m.opts.MaxChanges)))
+
+	default:
+		titleStyle := lipgloss.NewStyle().Foreground(theme.Title).Bold(true)
+		title := titleStyle.Render(fmt.Sprintf("Journeys from %s to %s", m.fromName, m.toName))
+
+		footerStyle := lipgloss.NewStyle().Foreground(theme.Muted)
+		scrollInfo := fmt.Sprintf("%d%%", int(m.viewport.ScrollPercent()*100))
+		footer := footerStyle.Render(fmt.Sprintf("↑/↓ scroll • %s • q to quit", scrollInfo))
+
+		v = tea.NewView(title + "\n\n" + m.viewport.View() + "\n\n" + footer + "\n")
+	}
+
+	v.AltScreen = true
+	return v
+}
+
+func (m JourneyModel) renderItineraries() string {
+	theme := CurrentTheme()
+	headingStyle := lipgloss.NewStyle().Foreground(theme.Time).Bold(true)
+	timeStyle := lipgloss.NewStyle().Foreground(theme.Time)
+	stationStyle := lipgloss.NewStyle().Foreground(theme.Leaving).Bold(true)
+	platformStyle := lipgloss.NewStyle().Foreground(theme.DepPlatform)
+	serviceStyle := lipgloss.NewStyle().Foreground(theme.Service)
+	changeStyle := lipgloss.NewStyle().Foreground(theme.Muted).Italic(true)
+
+	var b strings.Builder
+	for n, it := range m.itineraries {
+		if n > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(headingStyle.Render(fmt.Sprintf("%d. %s → %s", n+1, formatClock(it.Departs()), formatClock(it.Arrives()))))
+		b.WriteString(changeStyle.Render(fmt.Sprintf("  %s, %s", formatMinutes(it.Duration()), changesLabel(it.Changes()))))
+		b.WriteString("\n")
+
+		for i, leg := range it.Legs {
+			b.WriteString(fmt.Sprintf("   %s %s %s\n",
+				timeStyle.Render(formatClock(leg.Board.Time)),
+				stationStyle.Render(leg.Board.Name),
+				platformStyle.Render(platformLabel(leg.Board.Platform))))
+			b.WriteString(serviceStyle.Render(fmt.Sprintf("   │     %s to %s (%s)", leg.Operator, leg.Destination, formatMinutes(leg.Duration()))))
+			b.WriteString("\n")
+			b.WriteString(fmt.Sprintf("   %s %s %s\n",
+				timeStyle.Render(formatClock(leg.Alight.Time)),
+				stationStyle.Render(leg.Alight.Name),
+				platformStyle.Render(platformLabel(leg.Alight.Platform))))
+			if i < len(it.Legs)-1 {
+				b.WriteString(changeStyle.Render(fmt.Sprintf("   ⇄     Change at %s, %s wait", leg.Alight.Name, formatMinutes(it.Wait(i)))))
+				b.WriteString("\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+func changesLabel(changes int) string {
+	switch changes {
+	case 0:
+		return "direct"
+	case 1:
+		return "1 change"
+	}
+	return fmt.Sprintf("%d changes", changes)
+}
+
+func platformLabel(platform string) string {
+	if platform == "" {
+		return ""
+	}
+	return "plat " + platform
+}
+
+// formatMinutes formats a duration like the Duration column, e.g. "45min" or "1hr 5min".
+func formatMinutes(d time.Duration) string {
+	total := int(d.Round(time.Minute).Minutes())
+	if total < 60 {
+		return fmt.Sprintf("%dmin", total)
+	}
+	return fmt.Sprintf("%dhr %dmin", total/60, total%60)
+}
@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/baz-sh/rtt-cli/internal/stations"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// StationPickerModel asks which of several stations a command-line argument
+// meant. It draws inline, below the command, rather than taking over the
+// screen.
+type StationPickerModel struct {
+	query    string
+	matches  []stations.Match
+	cursor   int
+	selected bool
+}
+
+func NewStationPickerModel(query string, matches []stations.Match) StationPickerModel {
+	return StationPickerModel{query: query, matches: matches}
+}
+
+// Selected returns the station picked, or false if the user gave up.
+func (m StationPickerModel) Selected() (stations.Station, bool) {
+	if !m.selected {
+		return stations.Station{}, false
+	}
+	return m.matches[m.cursor].Station, true
+}
+
+func (m StationPickerModel) Init() tea.Cmd {
+	return tea.RequestBackgroundColor
+}
+
+func (m StationPickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.BackgroundColorMsg:
+		SetDarkMode(msg.IsDark())
+		return m, nil
+
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "ctrl+c", "q", "esc":
+			return m, tea.Quit
+		case "enter":
+			m.selected = true
+			return m, tea.Quit
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.matches)-1 {
+				m.cursor++
+			}
+		}
+	}
+	return m, nil
+}
+
+func (m StationPickerModel) View() tea.View {
+	theme := CurrentTheme()
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Title).Bold(true)
+	mutedStyle := lipgloss.NewStyle().Foreground(theme.Muted)
+	selectedStyle := lipgloss.NewStyle().Foreground(theme.Title).Bold(true)
+
+	// Once a choice is made, leave just the answer on screen
+	if m.selected {
+		s := m.matches[m.cursor].Station
+		return tea.NewView(fmt.Sprintf("%s %s %s\n", mutedStyle.Render(m.query+" →"), s.Name, mutedStyle.Render(s.Code)))
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(fmt.Sprintf("Which station did you mean by %q?", m.query)) + "\n")
+	for i, match := range m.matches {
+		line := match.Station.Name + " " + mutedStyle.Render(match.Station.Code)
+		if i == m.cursor {
+			b.WriteString(selectedStyle.Render("▸ ") + line + "\n")
+		} else {
+			b.WriteString("  " + line + "\n")
+		}
+	}
+	b.WriteString(mutedStyle.Render("↑/↓ choose • enter select • esc cancel") + "\n")
+	return tea.NewView(b.String())
+}
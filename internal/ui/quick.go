@@ -4,118 +4,156 @@ import (
 	"fmt"
 
 	"github.com/baz-sh/rtt-cli/internal/api"
+	"github.com/baz-sh/rtt-cli/internal/stations"
 	"charm.land/bubbles/v2/spinner"
-	"charm.land/bubbles/v2/viewport"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
-	"charm.land/lipgloss/v2/table"
 )
 
 type QuickDisplayModel struct {
-	fromName   string
-	fromCode   string
-	toName     string
-	toCode     string
-	apiClient  *api.Client
-	departures []api.Departure
-	spinner    spinner.Model
-	viewport   viewport.Model
-	loading    bool
-	ready      bool
-	err        error
+	fromName  string
+	fromCode  string
+	toName    string
+	toCode    string
+	apiClient *api.Client
+	search    api.SearchOptions
+	req       requestScope
+	results   resultsView
+	service   *serviceView
+	spinner   spinner.Model
+	loading   bool
+	width     int
+	height    int
+	err       error
 }
 
-type quickSearchCompleteMsg struct {
-	departures []api.Departure
-	err        error
-}
-
-func NewQuickDisplayModel(apiClient *api.Client, fromCode, toCode, fromName, toName string) QuickDisplayModel {
+func NewQuickDisplayModel(apiClient *api.Client, fromCode, toCode, fromName, toName string, search api.SearchOptions, opts DisplayOptions) QuickDisplayModel {
 	s := spinner.New(
 		spinner.WithSpinner(spinner.Points),
 		spinner.WithStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("205"))),
 	)
-	return QuickDisplayModel{
+	results := newResultsView(apiClient, opts)
+	results.search = search
+	results.from, results.to = fromCode, toCode
+	if !stations.IsGroup(fromCode) && !stations.IsGroup(toCode) {
+		results.emptyHint = fmt.Sprintf("There may be no direct trains. Try rtt-cli plan %s %s for journeys with changes.", fromCode, toCode)
+	}
+	m := QuickDisplayModel{
 		fromName:  fromName,
 		fromCode:  fromCode,
 		toName:    toName,
 		toCode:    toCode,
 		apiClient: apiClient,
+		search:    search,
+		results:   results,
 		spinner:   s,
 		loading:   true,
 	}
+	m.req.next()
+	return m
 }
 
 func (m QuickDisplayModel) Init() tea.Cmd {
-	return tea.Batch(tea.RequestBackgroundColor, m.spinner.Tick, m.fetchDepartures())
-}
-
-func (m QuickDisplayModel) fetchDepartures() tea.Cmd {
-	return func() tea.Msg {
-		departures, err := m.apiClient.GetDepartures(m.fromCode, m.toCode)
-		return quickSearchCompleteMsg{departures: departures, err: err}
-	}
+	return tea.Batch(tea.RequestBackgroundColor, m.spinner.Tick,
+		startStream(m.req.ctx, m.apiClient, m.fromCode, m.toCode, m.search), m.results.Init())
 }
 
 func (m QuickDisplayModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.BackgroundColorMsg:
 		SetDarkMode(msg.IsDark())
-		if m.ready {
-			m.viewport.SetContent(m.renderTable())
-		}
+		m.results.refresh()
 		return m, nil
 
 	case tea.KeyPressMsg:
-		if msg.String() == "q" || msg.String() == "ctrl+c" || msg.String() == "esc" {
+		if msg.String() == "ctrl+c" {
+			m.req.stop()
 			return m, tea.Quit
 		}
-		if m.ready {
-			switch msg.String() {
-			case "j":
-				m.viewport.ScrollDown(1)
-			case "k":
-				m.viewport.ScrollUp(1)
-			}
+		if m.service == nil && (msg.String() == "q" || msg.String() == "esc") {
+			m.req.stop()
+			return m, tea.Quit
 		}
 
-	case quickSearchCompleteMsg:
+	case streamStartedMsg:
+		if isCancelled(msg.err) {
+			return m, nil
+		}
 		m.loading = false
-		m.departures = msg.departures
 		m.err = msg.err
-		if m.ready {
-			m.viewport.SetContent(m.renderTable())
+		if msg.err != nil {
+			return m, nil
 		}
+		return m, m.results.StartStream(msg.stream)
+
+	case streamEventMsg:
+		cmd, err := m.results.AddStreamEvent(msg)
+		if err != nil && !isCancelled(err) {
+			if len(m.results.departures) == 0 {
+				m.err = err
+			} else {
+				m.results.SetRefreshError(err)
+			}
+		}
+		return m, cmd
+
+	case streamRefreshMsg:
+		if isCancelled(msg.err) {
+			return m, nil
+		}
+		if msg.err != nil {
+			m.results.SetRefreshError(msg.err)
+			return m, nil
+		}
+		m.results.SetResult(msg.result)
+		return m, m.results.ContinueStream(msg.stream, msg.loaded)
+
+	case refreshRequestMsg:
+		if m.loading || m.err != nil || m.results.refreshing {
+			return m, nil
+		}
+		return m, tea.Batch(m.results.StartRefresh(),
+			refreshStream(m.req.next(), m.apiClient, m.fromCode, m.toCode, m.search, max(m.results.loaded, streamPageSize)))
+
+	case clockTickMsg, watchTickMsg, busyTickMsg:
+		var cmd tea.Cmd
+		m.results, cmd = m.results.Update(msg)
+		return m, cmd
+
+	case openServiceMsg:
+		sv := newServiceView(m.apiClient, msg.serviceID, msg.label, m.width, m.height)
+		m.service = &sv
+		return m, sv.Init()
+
+	case closeServiceMsg:
+		m.service = nil
 		return m, nil
 
 	case tea.WindowSizeMsg:
-		headerHeight := 3 // title + blank line
-		footerHeight := 3 // blank line + footer + blank line
-		if !m.ready {
-			m.viewport = viewport.New(viewport.WithWidth(msg.Width), viewport.WithHeight(msg.Height-headerHeight-footerHeight))
-			m.viewport.SetContent(m.renderTable())
-			m.ready = true
-		} else {
-			m.viewport.SetWidth(msg.Width)
-			m.viewport.SetHeight(msg.Height - headerHeight - footerHeight)
-			m.viewport.SetContent(m.renderTable())
+		m.width = msg.Width
+		m.height = msg.Height
+		m.results.SetSize(msg.Width, msg.Height)
+		if m.service != nil {
+			m.service.SetSize(msg.Width, msg.Height)
 		}
 		return m, nil
 	}
 
-	if m.loading {
-		var cmd tea.Cmd
-		m.spinner, cmd = m.spinner.Update(msg)
+	if m.service != nil {
+		sv, cmd := m.service.Update(msg)
+		m.service = &sv
 		return m, cmd
 	}
 
-	if m.ready {
+	if m.loading {
 		var cmd tea.Cmd
-		m.viewport, cmd = m.viewport.Update(msg)
+		m.spinner, cmd = m.spinner.Update(msg)
 		return m, cmd
 	}
 
-	return m, nil
+	var cmd tea.Cmd
+	m.results, cmd = m.results.Update(msg)
+	return m, cmd
 }
 
 func (m QuickDisplayModel) View() tea.View {
@@ -128,7 +166,7 @@ func (m QuickDisplayModel) View() tea.View {
 			Bold(true).
 			Padding(1, 0)
 		v = tea.NewView(style.Render(fmt.Sprintf("%s Searching for trains from %s to %s...",
-			m.spinner.View(), m.fromName, m.toName)))
+			m.spinner.View(), m.fromName, m.toName)) + renderThrottle(m.apiClient.LimiterStatus()))
 		v.AltScreen = true
 		return v
 	}
@@ -138,88 +176,18 @@ func (m QuickDisplayModel) View() tea.View {
 			Foreground(theme.Error).
 			Bold(true).
 			Padding(1, 0)
-		v = tea.NewView(errorStyle.Render(fmt.Sprintf("Error: %v\n\nPress q to quit", m.err)))
+		v = tea.NewView(errorStyle.Render(fmt.Sprintf("%s\n\nPress q to quit", errorMessage(m.err))))
 		v.AltScreen = true
 		return v
 	}
 
-	if !m.ready || len(m.departures) == 0 {
-		emptyStyle := lipgloss.NewStyle().
-			Foreground(theme.Muted).
-			Padding(1, 0)
-		v = tea.NewView(emptyStyle.Render("No departures found.\n\nPress q to quit"))
+	if m.service != nil {
+		v = tea.NewView(m.service.View())
 		v.AltScreen = true
 		return v
 	}
 
-	titleStyle := lipgloss.NewStyle().Foreground(theme.Title).Bold(true)
-	title := titleStyle.Render(fmt.Sprintf("Trains from %s to %s", m.fromName, m.toName))
-
-	footerStyle := lipgloss.NewStyle().Foreground(theme.Muted)
-	scrollInfo := fmt.Sprintf("%d%%", int(m.viewport.ScrollPercent()*100))
-	footer := footerStyle.Render(fmt.Sprintf("↑/↓ scroll • %s • q to quit", scrollInfo))
-
-	v = tea.NewView(title + "\n\n" + m.viewport.View() + "\n\n" + footer + "\n")
+	v = tea.NewView(m.results.View(searchTitle(m.fromName, m.toName, m.search)))
 	v.AltScreen = true
 	return v
 }
-
-func (m QuickDisplayModel) renderTable() string {
-	theme := CurrentTheme()
-
-	rows := [][]string{}
-	addedSeparator := false
-	for _, dep := range m.departures {
-		if dep.NextDay && !addedSeparator {
-			rows = append(rows, []string{"── Tomorrow ──", "", "", "", "", ""})
-			addedSeparator = true
-		}
-		rows = append(rows, []string{
-			dep.BookedDepartureTime,
-			dep.Leaving,
-			dep.DeparturePlatform,
-			dep.Platform,
-			truncate(dep.Service, 20),
-			dep.Duration,
-		})
-	}
-
-	t := table.New().
-		Border(lipgloss.NormalBorder()).
-		BorderStyle(lipgloss.NewStyle().Foreground(theme.Border)).
-		Headers("Time", "Leaving", "Dep Plat", "Arr Plat", "Service", "Duration").
-		Rows(rows...).
-		StyleFunc(func(row, col int) lipgloss.Style {
-			if row == -1 {
-				return lipgloss.NewStyle().
-					Foreground(theme.Muted).
-					Bold(true).
-					Align(lipgloss.Left)
-			}
-			if row >= 0 && row < len(rows) && rows[row][0] == "── Tomorrow ──" {
-				return lipgloss.NewStyle().
-					Foreground(theme.Muted).
-					Bold(true).
-					Align(lipgloss.Left)
-			}
-			base := lipgloss.NewStyle().Align(lipgloss.Left)
-			switch col {
-			case 0: // Time
-				return base.Foreground(theme.Time).Bold(true)
-			case 1: // Leaving
-				return base.Foreground(theme.Leaving)
-			case 2: // Dep Platform
-				return base.Foreground(theme.DepPlatform)
-			case 3: // Arr Platform
-				return base.Foreground(theme.ArrPlatform)
-			case 4: // Service
-				return base.Foreground(theme.Service)
-			case 5: // Duration
-				return base.Foreground(theme.Duration)
-			default:
-				return base
-			}
-		})
-
-	return t.String()
-}
@@ -0,0 +1,33 @@
+package ui
+
+import (
+	"context"
+	"errors"
+)
+
+// requestScope holds the context of a model's in-flight API request, so it can
+// be cancelled when the user goes back, quits or starts another request.
+type requestScope struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// next cancels any request still in flight and returns the context for a new one.
+func (r *requestScope) next() context.Context {
+	r.stop()
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	return r.ctx
+}
+
+// stop cancels the request in flight, if any.
+func (r *requestScope) stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+}
+
+// isCancelled reports whether err is the result of a request being cancelled,
+// in which case the result should be dropped rather than shown.
+func isCancelled(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
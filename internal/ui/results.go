@@ -0,0 +1,446 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// resultsView is the scrollable departures table shared by the quick display
+// and the selector. It keeps a cursor so a service can be opened for details.
+type resultsView struct {
+	apiClient  *api.Client // for the rate limiter's status while trains load
+	departures []api.Departure
+	skipped    []api.ServiceError // services that failed to load
+	showSkip   bool               // list the skipped services under the table
+	opts       DisplayOptions
+	search     api.SearchOptions
+	from, to   string          // codes searched between, either of which may be a station group
+	cursor     int             // index into the visible departures
+	changed    map[string]bool // service IDs whose platform or expected time changed in the last refresh
+	updatedAt  time.Time
+	refreshing bool
+	refreshErr error
+	viewport   viewport.Model
+	ready      bool
+	emptyHint  string // extra advice shown when there are no departures
+
+	// Streaming searches load later trains as the user scrolls to them
+	stream    *api.DepartureStream // nil once every service has been loaded
+	requested int                  // services requested from the stream
+	loaded    int                  // services the stream has reported
+	busyTick  bool                 // a busyTickMsg is pending
+}
+
+// openServiceMsg asks the parent model to show a service's calling points.
+type openServiceMsg struct {
+	serviceID string
+	label     string
+}
+
+// refreshRequestMsg asks the parent model to search again, either because the
+// user pressed r or because the watch interval elapsed.
+type refreshRequestMsg struct{}
+
+// clockTickMsg updates the countdowns once a second in watch mode.
+type clockTickMsg time.Time
+
+// watchTickMsg fires every watch interval to trigger a refresh.
+type watchTickMsg struct{}
+
+// busyTickMsg redraws the results once a second while trains are loading, so
+// a rate-limit countdown stays current.
+type busyTickMsg struct{}
+
+// searchTitle describes a search for the results title, e.g.
+// "Trains from London Euston to Manchester Piccadilly arriving by 09:00 on Sat 18 Oct".
+func searchTitle(fromName, toName string, search api.SearchOptions) string {
+	title := fmt.Sprintf("Trains from %s to %s", fromName, toName)
+	if len(search.Via) > 0 {
+		title += " via " + strings.Join(search.Via, ", ")
+	}
+	if len(search.Avoid) > 0 {
+		title += " avoiding " + strings.Join(search.Avoid, ", ")
+	}
+	if search.At.IsZero() && !search.ArriveBy {
+		return title
+	}
+
+	at := search.At
+	if at.IsZero() {
+		at = time.Now()
+	}
+	verb := "departing from"
+	if search.ArriveBy {
+		verb = "arriving by"
+	}
+	return fmt.Sprintf("%s %s %s on %s", title, verb, at.Format("15:04"), at.Format("Mon 2 Jan"))
+}
+
+func newResultsView(apiClient *api.Client, opts DisplayOptions) resultsView {
+	return resultsView{apiClient: apiClient, opts: opts}
+}
+
+// SetSize resizes the viewport to fit a screen of the given dimensions.
+func (r *resultsView) SetSize(width, height int) {
+	headerHeight := 3 // title + blank line
+	footerHeight := 3 // blank line + footer + blank line
+	if !r.ready {
+		r.viewport = viewport.New(viewport.WithWidth(width), viewport.WithHeight(height-headerHeight-footerHeight))
+		r.ready = true
+	} else {
+		r.viewport.SetWidth(width)
+		r.viewport.SetHeight(height - headerHeight - footerHeight)
+	}
+	r.refresh()
+}
+
+// Init starts the watch mode timers, if enabled.
+func (r resultsView) Init() tea.Cmd {
+	if r.opts.WatchInterval <= 0 {
+		return nil
+	}
+	return tea.Batch(clockTick(), watchTick(r.opts.WatchInterval))
+}
+
+func clockTick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg { return clockTickMsg(t) })
+}
+
+func watchTick(interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(time.Time) tea.Msg { return watchTickMsg{} })
+}
+
+// startBusyTick starts redrawing once a second while trains load, unless
+// that is already happening.
+func (r *resultsView) startBusyTick() tea.Cmd {
+	if r.busyTick {
+		return nil
+	}
+	r.busyTick = true
+	return tea.Tick(time.Second, func(time.Time) tea.Msg { return busyTickMsg{} })
+}
+
+// busy reports whether trains are being loaded or refreshed.
+func (r resultsView) busy() bool {
+	return r.refreshing || r.streaming()
+}
+
+// SetResult replaces the displayed departures, remembering which services
+// changed platform or expected time since the previous set.
+func (r *resultsView) SetResult(result api.DepartureResult) {
+	selected, hadSelection := r.Selected()
+	departures := result.Departures
+	r.skipped = result.Skipped
+	if len(r.skipped) == 0 {
+		r.showSkip = false
+	}
+
+	if r.departures != nil {
+		previous := make(map[string]api.Departure, len(r.departures))
+		for _, dep := range r.departures {
+			previous[dep.ServiceID] = dep
+		}
+		r.changed = make(map[string]bool)
+		for _, dep := range departures {
+			old, ok := previous[dep.ServiceID]
+			if ok && (old.DeparturePlatform != dep.DeparturePlatform ||
+				old.ExpectedDepartureTime != dep.ExpectedDepartureTime ||
+				old.Cancelled != dep.Cancelled) {
+				r.changed[dep.ServiceID] = true
+			}
+		}
+	}
+
+	r.departures = departures
+	r.updatedAt = time.Now()
+	r.refreshing = false
+	r.refreshErr = nil
+
+	// Keep the cursor on the same train if it is still listed
+	if hadSelection {
+		for i, dep := range r.visible() {
+			if dep.ServiceID == selected.ServiceID {
+				r.cursor = i
+				break
+			}
+		}
+	}
+	r.refresh()
+}
+
+// StartStream shows the results of a new streaming search as they arrive,
+// replacing any shown, and returns the command that waits for the first.
+func (r *resultsView) StartStream(stream *api.DepartureStream) tea.Cmd {
+	r.departures = nil
+	r.skipped = nil
+	r.showSkip = false
+	r.changed = nil
+	r.cursor = 0
+	r.updatedAt = time.Now()
+	r.refreshing = false
+	r.refreshErr = nil
+	r.stream, r.requested, r.loaded = stream, 0, 0
+	r.refresh()
+	return tea.Batch(waitForEvent(stream), r.startBusyTick())
+}
+
+// ContinueStream carries on loading later trains from a stream that has
+// already reported its first loaded services, e.g. after a refresh.
+func (r *resultsView) ContinueStream(stream *api.DepartureStream, loaded int) tea.Cmd {
+	r.stream, r.requested, r.loaded = stream, loaded, loaded
+	if loaded >= stream.Total() {
+		r.stream = nil
+		return nil
+	}
+	r.refresh()
+	return tea.Batch(waitForEvent(stream), r.startBusyTick())
+}
+
+// AddStreamEvent adds the next result of the streaming search and returns the
+// command that waits for the one after. It returns the stream's error if it
+// stopped early. Events from a stream that has been replaced are ignored.
+func (r *resultsView) AddStreamEvent(msg streamEventMsg) (tea.Cmd, error) {
+	if msg.stream != r.stream {
+		return nil, nil
+	}
+	if msg.done {
+		r.stream = nil
+		r.refresh()
+		return nil, msg.stream.Err()
+	}
+
+	r.loaded = msg.event.Index + 1
+	if dep := msg.event.Departure; dep != nil {
+		r.departures = append(r.departures, *dep)
+	}
+	if skipped := msg.event.Skipped; skipped != nil {
+		r.skipped = append(r.skipped, *skipped)
+	}
+	r.refresh()
+	return waitForEvent(r.stream), nil
+}
+
+// loadMore requests the next page of services from the stream when the rows
+// loaded so far don't fill the screen or the cursor is close to the last one.
+func (r *resultsView) loadMore() {
+	if r.stream == nil || r.loaded < r.requested || r.requested >= r.stream.Total() {
+		return
+	}
+	if len(r.visible()) < max(r.cursor+1+prefetchRows, r.viewport.Height()) {
+		r.requested += streamPageSize
+		r.stream.Request(r.requested)
+	}
+}
+
+// streaming reports whether the stream has more services left to load.
+func (r resultsView) streaming() bool {
+	return r.stream != nil && r.loaded < r.stream.Total()
+}
+
+// StartRefresh marks a background refresh as in progress, and returns the
+// command that keeps the view redrawn until it finishes.
+func (r *resultsView) StartRefresh() tea.Cmd {
+	r.refreshing = true
+	return r.startBusyTick()
+}
+
+// SetRefreshError records a failed background refresh, keeping the existing results.
+func (r *resultsView) SetRefreshError(err error) {
+	r.refreshing = false
+	r.refreshErr = err
+}
+
+// tick recomputes countdowns and drops trains that have left.
+func (r *resultsView) tick(now time.Time) {
+	var remaining []api.Departure
+	for _, dep := range r.departures {
+		if dep.Departed(now) {
+			continue
+		}
+		dep.UpdateLeaving(now)
+		remaining = append(remaining, dep)
+	}
+	r.departures = remaining
+	r.refresh()
+}
+
+func (r resultsView) visible() []api.Departure {
+	return visibleDepartures(r.departures, r.opts)
+}
+
+// Selected returns the departure under the cursor.
+func (r resultsView) Selected() (api.Departure, bool) {
+	visible := r.visible()
+	if r.cursor < 0 || r.cursor >= len(visible) {
+		return api.Departure{}, false
+	}
+	return visible[r.cursor], true
+}
+
+// refresh re-renders the table and keeps the cursor row on screen. It also
+// loads more trains if the cursor has moved close to the last loaded one.
+func (r *resultsView) refresh() {
+	r.loadMore()
+	if !r.ready {
+		return
+	}
+	visible := r.visible()
+	r.cursor = max(0, min(r.cursor, len(visible)-1))
+	content, line := renderDepartureTable(visible, r.opts.columns(r.from, r.to, r.search), r.changed, r.cursor)
+	if r.showSkip {
+		content += renderSkippedNotes(r.skipped)
+	}
+	r.viewport.SetContent(content)
+	if r.cursor == 0 {
+		r.viewport.GotoTop()
+	} else {
+		r.viewport.EnsureVisible(line, 0, 0)
+	}
+}
+
+func (r resultsView) Update(msg tea.Msg) (resultsView, tea.Cmd) {
+	switch msg := msg.(type) {
+	case clockTickMsg:
+		r.tick(time.Time(msg))
+		return r, clockTick()
+
+	case watchTickMsg:
+		return r, tea.Batch(watchTick(r.opts.WatchInterval), requestRefresh)
+
+	case busyTickMsg:
+		r.busyTick = false
+		if r.busy() {
+			return r, r.startBusyTick()
+		}
+		return r, nil
+	}
+
+	if msg, ok := msg.(tea.KeyPressMsg); ok && msg.String() == "r" {
+		return r, requestRefresh
+	}
+
+	if !r.ready {
+		return r, nil
+	}
+
+	if msg, ok := msg.(tea.KeyPressMsg); ok {
+		switch msg.String() {
+		case "down", "j":
+			r.cursor++
+			r.refresh()
+			return r, nil
+		case "up", "k":
+			r.cursor--
+			r.refresh()
+			return r, nil
+		case "c":
+			r.opts.HideCancelled = !r.opts.HideCancelled
+			r.refresh()
+			return r, nil
+		case "e":
+			if len(r.skipped) == 0 {
+				return r, nil
+			}
+			r.showSkip = !r.showSkip
+			r.refresh()
+			if r.showSkip {
+				r.viewport.GotoBottom()
+			}
+			return r, nil
+		case "enter":
+			dep, ok := r.Selected()
+			if !ok {
+				return r, nil
+			}
+			return r, func() tea.Msg {
+				return openServiceMsg{serviceID: dep.ServiceID, label: dep.BookedDepartureTime + " " + dep.Service}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	r.viewport, cmd = r.viewport.Update(msg)
+	return r, cmd
+}
+
+func requestRefresh() tea.Msg {
+	return refreshRequestMsg{}
+}
+
+// statusLine reports when the results were last updated, if that is useful to
+// show, and whether loading more of them is held up by a rate limit.
+func (r resultsView) statusLine() string {
+	theme := CurrentTheme()
+	mutedStyle := lipgloss.NewStyle().Foreground(theme.Muted)
+	var status string
+	switch {
+	case r.refreshing:
+		status = mutedStyle.Render("Refreshing...")
+	case r.refreshErr != nil:
+		status = lipgloss.NewStyle().Foreground(theme.Error).Render("Refresh failed: " + errorMessage(r.refreshErr))
+	case r.streaming() && r.loaded < r.requested:
+		status = mutedStyle.Render(fmt.Sprintf("Loading trains... (%d of %d checked)", r.loaded, r.stream.Total()))
+	case r.streaming():
+		status = mutedStyle.Render("Scroll down to load later trains")
+	case r.opts.WatchInterval > 0:
+		status = mutedStyle.Render(fmt.Sprintf("Updated %s • refreshing every %s",
+			r.updatedAt.Format("15:04:05"), r.opts.WatchInterval))
+	}
+
+	if r.busy() {
+		if notice := throttleNotice(r.apiClient.LimiterStatus()); notice != "" {
+			if status != "" {
+				status += mutedStyle.Render(" • ")
+			}
+			status += notice
+		}
+	}
+	return status
+}
+
+// View renders the title, table and footer, or a placeholder if there are no results.
+func (r resultsView) View(title string) string {
+	theme := CurrentTheme()
+
+	if !r.ready || len(r.departures) == 0 {
+		emptyStyle := lipgloss.NewStyle().
+			Foreground(theme.Muted).
+			Padding(1, 0)
+		if r.streaming() {
+			return emptyStyle.Render(fmt.Sprintf("Looking for trains... (%d of %d checked)", r.loaded, r.stream.Total()) +
+				renderThrottle(r.apiClient.LimiterStatus()) + "\n\nPress q to quit")
+		}
+		msg := "No departures found."
+		if len(r.skipped) > 0 {
+			// Nothing to scroll to, so list the missing services straight away
+			msg += "\n\n" + skippedSummary(len(r.skipped)) + ":" + renderSkippedNotes(r.skipped)
+		} else if r.emptyHint != "" {
+			msg += "\n\n" + r.emptyHint
+		}
+		return emptyStyle.Render(msg + "\n\nPress r to refresh or q to quit")
+	}
+
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Title).Bold(true)
+
+	footerStyle := lipgloss.NewStyle().Foreground(theme.Muted)
+	scrollInfo := fmt.Sprintf("%d%%", int(r.viewport.ScrollPercent()*100))
+	footer := footerStyle.Render(fmt.Sprintf("↑/↓ select • enter calling points • c %s • r refresh • %s • q to quit",
+		cancelledToggleLabel(r.opts), scrollInfo))
+
+	status := r.statusLine()
+	if len(r.skipped) > 0 {
+		note := lipgloss.NewStyle().Foreground(theme.Late).Render(skippedSummary(len(r.skipped)) + " (press e for details)")
+		if status != "" {
+			note += footerStyle.Render(" • ")
+		}
+		status = note + status
+	}
+
+	return titleStyle.Render(title) + "\n\n" + r.viewport.View() + "\n\n" + footer + "\n" + status
+}
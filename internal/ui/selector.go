@@ -3,16 +3,16 @@ package ui
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/baz-sh/rtt-cli/internal/api"
 	"github.com/baz-sh/rtt-cli/internal/stations"
 	"charm.land/bubbles/v2/key"
 	"charm.land/bubbles/v2/list"
 	"charm.land/bubbles/v2/spinner"
-	"charm.land/bubbles/v2/viewport"
+	"charm.land/bubbles/v2/textinput"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
-	"charm.land/lipgloss/v2/table"
 	"github.com/charmbracelet/x/ansi"
 )
 
@@ -96,11 +96,72 @@ func (d stationDelegate) Render(w io.Writer, m list.Model, index int, item list.
 	}
 }
 
+// nearbyFilterSize is how many stations a "near PLACE" filter lists.
+const nearbyFilterSize = 10
+
+// filterStations ranks the station list with stations.Search, so typing a
+// CRS code puts that station first and names match however they are spelt.
+// A filter of "near PLACE" instead lists the stations closest to PLACE,
+// nearest first. targets are the items' FilterValues, which end in the
+// station code.
+func filterStations(term string, targets []string) []list.Rank {
+	positions := make(map[string]int, len(targets))
+	for i, target := range targets {
+		positions[target[strings.LastIndexByte(target, ' ')+1:]] = i
+	}
+
+	var ranks []list.Rank
+	if place, ok := nearbyFilter(term); ok {
+		lat, lon, err := stations.Locate(place)
+		if err != nil {
+			return nil
+		}
+		for _, n := range stations.Near(lat, lon, nearbyFilterSize) {
+			if i, ok := positions[n.Station.Code]; ok {
+				ranks = append(ranks, list.Rank{Index: i})
+			}
+		}
+		return ranks
+	}
+
+	for _, match := range stations.Search(term) {
+		if i, ok := positions[match.Station.Code]; ok {
+			ranks = append(ranks, list.Rank{Index: i, MatchedIndexes: match.Matched})
+		}
+	}
+	return ranks
+}
+
+// nearbyFilter returns the place in a "near PLACE" filter.
+func nearbyFilter(term string) (string, bool) {
+	if len(term) < len("near ") || !strings.EqualFold(term[:len("near ")], "near ") {
+		return "", false
+	}
+	place := strings.TrimSpace(term[len("near "):])
+	return place, place != ""
+}
+
+// nearbyCoverageNote warns under a "near PLACE" filter that stations whose
+// locations aren't known can't be listed, while there are any.
+func nearbyCoverageNote(filter string) string {
+	if _, ok := nearbyFilter(filter); !ok {
+		return ""
+	}
+	located, total := stations.Coverage()
+	if located == total {
+		return ""
+	}
+	return "\n" + lipgloss.NewStyle().Foreground(CurrentTheme().Muted).
+		Render(fmt.Sprintf("Only the %d of %d stations whose locations are known are searched.", located, total))
+}
+
 type selectionStep int
 
 const (
 	selectingFrom selectionStep = iota
 	selectingTo
+	selectingTime
+	selectingRoute
 	searching
 	showingResults
 )
@@ -110,24 +171,31 @@ type SelectorModel struct {
 	fromStation *stationItem
 	toStation   *stationItem
 	list        list.Model
+	timeInput   textinput.Model
+	arriveBy    bool
+	timeErr     error
+	viaInput    textinput.Model
+	avoidInput  textinput.Model
+	routeErr    error
+	search      api.SearchOptions
+	req         requestScope
 	spinner     spinner.Model
 	apiClient   *api.Client
-	departures  []api.Departure
+	results     resultsView
+	service     *serviceView
 	err         error
 	width       int
 	height      int
-	viewport    viewport.Model
-}
-
-type searchCompleteMsg struct {
-	departures []api.Departure
-	err        error
 }
 
-func NewSelectorModel(apiClient *api.Client) SelectorModel {
-	items := make([]list.Item, len(stations.Stations))
-	for i, station := range stations.Stations {
-		items[i] = stationItem{name: station.Name, code: station.Code}
+func NewSelectorModel(apiClient *api.Client, opts DisplayOptions) SelectorModel {
+	// Station groups come first, so "London (any)" is easy to find
+	var items []list.Item
+	for _, group := range stations.Groups() {
+		items = append(items, stationItem{name: group.Name, code: group.Code})
+	}
+	for _, station := range stations.Stations {
+		items = append(items, stationItem{name: station.Name, code: station.Code})
 	}
 
 	delegate := newStationDelegate(true)
@@ -135,17 +203,34 @@ func NewSelectorModel(apiClient *api.Client) SelectorModel {
 	l.Title = "Select Departure Station"
 	l.SetShowStatusBar(false)
 	l.SetFilteringEnabled(true)
+	l.Filter = filterStations
 
 	s := spinner.New(
 		spinner.WithSpinner(spinner.Points),
 		spinner.WithStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("205"))),
 	)
 
+	ti := textinput.New()
+	ti.Placeholder = "now"
+	ti.CharLimit = 16
+
+	via := textinput.New()
+	via.Placeholder = "e.g. CRE"
+	via.CharLimit = 40
+
+	avoid := textinput.New()
+	avoid.Placeholder = "e.g. SOT"
+	avoid.CharLimit = 40
+
 	return SelectorModel{
-		step:      selectingFrom,
-		list:      l,
-		spinner:   s,
-		apiClient: apiClient,
+		step:       selectingFrom,
+		list:       l,
+		timeInput:  ti,
+		viaInput:   via,
+		avoidInput: avoid,
+		spinner:    s,
+		apiClient:  apiClient,
+		results:    newResultsView(apiClient, opts),
 	}
 }
 
@@ -160,22 +245,22 @@ func (m SelectorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		SetDarkMode(isDark)
 		m.list.Styles = list.DefaultStyles(isDark)
 		m.list.SetDelegate(newStationDelegate(isDark))
-		if m.step == showingResults {
-			m.initResultsViewport()
-		}
+		m.results.refresh()
 		return m, nil
 
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
 		m.list.SetSize(msg.Width, msg.Height-2)
-		if m.step == showingResults {
-			m.initResultsViewport()
+		m.results.SetSize(msg.Width, msg.Height)
+		if m.service != nil {
+			m.service.SetSize(msg.Width, msg.Height)
 		}
 		return m, nil
 
 	case tea.KeyPressMsg:
 		if msg.String() == "ctrl+c" {
+			m.req.stop()
 			return m, tea.Quit
 		}
 
@@ -197,31 +282,145 @@ func (m SelectorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return m, nil
 				} else {
 					m.toStation = &station
-					m.step = searching
-					return m, tea.Batch(m.spinner.Tick, m.searchDepartures())
+					m.step = selectingTime
+					m.timeInput.Reset()
+					m.timeErr = nil
+					return m, m.timeInput.Focus()
 				}
 			}
 
-		case showingResults:
-			if msg.String() == "esc" || msg.String() == "q" {
-				return m, tea.Quit
+		case selectingTime:
+			switch msg.String() {
+			case "esc":
+				m.timeInput.Blur()
+				m.step = selectingTo
+				return m, nil
+			case "tab":
+				m.arriveBy = !m.arriveBy
+				return m, nil
+			case "enter":
+				at, err := api.ParseSearchTime(m.timeInput.Value(), m.apiClient.Now())
+				if err == nil && m.arriveBy && at.IsZero() {
+					err = fmt.Errorf("enter a time to arrive by")
+				}
+				if err != nil {
+					m.timeErr = err
+					return m, nil
+				}
+				m.timeInput.Blur()
+				m.search = api.SearchOptions{At: at, ArriveBy: m.arriveBy}
+				m.step = selectingRoute
+				m.routeErr = nil
+				m.avoidInput.Blur()
+				return m, m.viaInput.Focus()
 			}
+
+		case selectingRoute:
 			switch msg.String() {
-			case "j":
-				m.viewport.ScrollDown(1)
-			case "k":
-				m.viewport.ScrollUp(1)
+			case "esc":
+				m.viaInput.Blur()
+				m.avoidInput.Blur()
+				m.step = selectingTime
+				return m, m.timeInput.Focus()
+			case "tab", "shift+tab", "up", "down":
+				if m.viaInput.Focused() {
+					m.viaInput.Blur()
+					return m, m.avoidInput.Focus()
+				}
+				m.avoidInput.Blur()
+				return m, m.viaInput.Focus()
+			case "enter":
+				via := api.ParseStationCodes(m.viaInput.Value())
+				avoid := api.ParseStationCodes(m.avoidInput.Value())
+				if code, ok := unknownStation(append(via, avoid...)); ok {
+					m.routeErr = fmt.Errorf("unknown station code %q", code)
+					return m, nil
+				}
+				m.viaInput.Blur()
+				m.avoidInput.Blur()
+				m.search.Via, m.search.Avoid = via, avoid
+				m.results.search = m.search
+				m.results.from, m.results.to = m.fromStation.code, m.toStation.code
+				m.step = searching
+				return m, tea.Batch(m.spinner.Tick,
+					startStream(m.req.next(), m.apiClient, m.fromStation.code, m.toStation.code, m.search))
+			}
+
+		case searching:
+			if msg.String() == "esc" {
+				// Abandon the search and go back to change it
+				m.req.stop()
+				m.step = selectingRoute
+				return m, m.viaInput.Focus()
+			}
+
+		case showingResults:
+			if m.service == nil && (msg.String() == "esc" || msg.String() == "q") {
+				m.req.stop()
+				return m, tea.Quit
 			}
-			var cmd tea.Cmd
-			m.viewport, cmd = m.viewport.Update(msg)
-			return m, cmd
 		}
 
-	case searchCompleteMsg:
+	case streamStartedMsg:
+		if isCancelled(msg.err) || m.step != searching {
+			return m, nil
+		}
 		m.step = showingResults
-		m.departures = msg.departures
 		m.err = msg.err
-		m.initResultsViewport()
+		if msg.err != nil {
+			return m, nil
+		}
+		// Watching only makes sense for trains leaving from now
+		if !m.search.At.IsZero() {
+			m.results.opts.WatchInterval = 0
+		}
+		return m, tea.Batch(m.results.StartStream(msg.stream), m.results.Init())
+
+	case streamEventMsg:
+		if m.step != showingResults {
+			return m, nil
+		}
+		cmd, err := m.results.AddStreamEvent(msg)
+		if err != nil && !isCancelled(err) {
+			if len(m.results.departures) == 0 {
+				m.err = err
+			} else {
+				m.results.SetRefreshError(err)
+			}
+		}
+		return m, cmd
+
+	case streamRefreshMsg:
+		if isCancelled(msg.err) || m.step != showingResults {
+			return m, nil
+		}
+		if msg.err != nil {
+			m.results.SetRefreshError(msg.err)
+			return m, nil
+		}
+		m.results.SetResult(msg.result)
+		return m, m.results.ContinueStream(msg.stream, msg.loaded)
+
+	case refreshRequestMsg:
+		if m.step != showingResults || m.err != nil || m.results.refreshing {
+			return m, nil
+		}
+		return m, tea.Batch(m.results.StartRefresh(),
+			refreshStream(m.req.next(), m.apiClient, m.fromStation.code, m.toStation.code, m.search,
+				max(m.results.loaded, streamPageSize)))
+
+	case clockTickMsg, watchTickMsg, busyTickMsg:
+		var cmd tea.Cmd
+		m.results, cmd = m.results.Update(msg)
+		return m, cmd
+
+	case openServiceMsg:
+		sv := newServiceView(m.apiClient, msg.serviceID, msg.label, m.width, m.height)
+		m.service = &sv
+		return m, sv.Init()
+
+	case closeServiceMsg:
+		m.service = nil
 		return m, nil
 	}
 
@@ -229,8 +428,24 @@ func (m SelectorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch m.step {
 	case selectingFrom, selectingTo:
 		m.list, cmd = m.list.Update(msg)
+	case selectingTime:
+		m.timeInput, cmd = m.timeInput.Update(msg)
+	case selectingRoute:
+		if m.viaInput.Focused() {
+			m.viaInput, cmd = m.viaInput.Update(msg)
+		} else {
+			m.avoidInput, cmd = m.avoidInput.Update(msg)
+		}
 	case searching:
 		m.spinner, cmd = m.spinner.Update(msg)
+	case showingResults:
+		if m.service != nil {
+			var sv serviceView
+			sv, cmd = m.service.Update(msg)
+			m.service = &sv
+		} else {
+			m.results, cmd = m.results.Update(msg)
+		}
 	}
 
 	return m, cmd
@@ -241,7 +456,13 @@ func (m SelectorModel) View() tea.View {
 
 	switch m.step {
 	case selectingFrom, selectingTo:
-		v = tea.NewView(m.list.View())
+		v = tea.NewView(m.list.View() + nearbyCoverageNote(m.list.FilterValue()))
+
+	case selectingTime:
+		v = tea.NewView(m.renderTimePicker())
+
+	case selectingRoute:
+		v = tea.NewView(m.renderRoutePicker())
 
 	case searching:
 		theme := CurrentTheme()
@@ -250,7 +471,9 @@ func (m SelectorModel) View() tea.View {
 			Bold(true).
 			Padding(1, 0)
 		v = tea.NewView(style.Render(fmt.Sprintf("%s Searching for trains from %s to %s...",
-			m.spinner.View(), m.fromStation.name, m.toStation.name)))
+			m.spinner.View(), m.fromStation.name, m.toStation.name)) +
+			renderThrottle(m.apiClient.LimiterStatus()) + "\n" +
+			lipgloss.NewStyle().Foreground(theme.Muted).Render("Press esc to cancel"))
 
 	case showingResults:
 		if m.err != nil {
@@ -259,9 +482,11 @@ func (m SelectorModel) View() tea.View {
 				Foreground(theme.Error).
 				Bold(true).
 				Padding(1, 0)
-			v = tea.NewView(errorStyle.Render(fmt.Sprintf("Error: %v\n\nPress q to quit", m.err)))
+			v = tea.NewView(errorStyle.Render(fmt.Sprintf("%s\n\nPress q to quit", errorMessage(m.err))))
+		} else if m.service != nil {
+			v = tea.NewView(m.service.View())
 		} else {
-			v = tea.NewView(m.renderDepartures())
+			v = tea.NewView(m.results.View(searchTitle(m.fromStation.name, m.toStation.name, m.search)))
 		}
 	}
 
@@ -269,103 +494,63 @@ func (m SelectorModel) View() tea.View {
 	return v
 }
 
-func (m *SelectorModel) searchDepartures() tea.Cmd {
-	return func() tea.Msg {
-		departures, err := m.apiClient.GetDepartures(m.fromStation.code, m.toStation.code)
-		return searchCompleteMsg{departures: departures, err: err}
-	}
-}
-
-func (m *SelectorModel) initResultsViewport() {
-	headerHeight := 3 // title + blank line
-	footerHeight := 3 // blank line + footer + blank line
-	m.viewport = viewport.New(viewport.WithWidth(m.width), viewport.WithHeight(m.height-headerHeight-footerHeight))
-	m.viewport.SetContent(m.renderTable())
-}
-
-func (m SelectorModel) renderDepartures() string {
+func (m SelectorModel) renderTimePicker() string {
 	theme := CurrentTheme()
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Title).Bold(true)
+	labelStyle := lipgloss.NewStyle().Foreground(theme.Muted)
+	activeStyle := lipgloss.NewStyle().Foreground(theme.Time).Bold(true).Underline(true)
+	inactiveStyle := lipgloss.NewStyle().Foreground(theme.Muted)
 
-	if len(m.departures) == 0 {
-		emptyStyle := lipgloss.NewStyle().
-			Foreground(theme.Muted).
-			Padding(1, 0)
-		return emptyStyle.Render("No departures found.\n\nPress q to quit")
+	depart, arrive := activeStyle, inactiveStyle
+	if m.arriveBy {
+		depart, arrive = inactiveStyle, activeStyle
 	}
 
-	titleStyle := lipgloss.NewStyle().Foreground(theme.Title).Bold(true)
-	title := titleStyle.Render(fmt.Sprintf("Trains from %s to %s", m.fromStation.name, m.toStation.name))
+	view := titleStyle.Render("When do you want to travel?") + "\n\n" +
+		labelStyle.Render("From: ") + m.fromStation.name + "\n" +
+		labelStyle.Render("To:   ") + m.toStation.name + "\n\n" +
+		depart.Render("Depart at") + "  " + arrive.Render("Arrive by") + "\n" +
+		m.timeInput.View() + "\n\n"
 
-	footerStyle := lipgloss.NewStyle().Foreground(theme.Muted)
-	scrollInfo := fmt.Sprintf("%d%%", int(m.viewport.ScrollPercent()*100))
-	footer := footerStyle.Render(fmt.Sprintf("↑/↓ scroll • %s • q to quit", scrollInfo))
+	if m.timeErr != nil {
+		view += lipgloss.NewStyle().Foreground(theme.Error).Render(m.timeErr.Error()) + "\n\n"
+	}
 
-	return title + "\n\n" + m.viewport.View() + "\n\n" + footer + "\n"
+	return view + labelStyle.Render("HH:MM or YYYY-MM-DDTHH:MM, blank for now • tab depart/arrive • enter next • esc back")
 }
 
-func (m SelectorModel) renderTable() string {
+func (m SelectorModel) renderRoutePicker() string {
 	theme := CurrentTheme()
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Title).Bold(true)
+	labelStyle := lipgloss.NewStyle().Foreground(theme.Muted)
+	activeStyle := lipgloss.NewStyle().Foreground(theme.Time).Bold(true)
 
-	rows := [][]string{}
-	addedSeparator := false
-	for _, dep := range m.departures {
-		if dep.NextDay && !addedSeparator {
-			rows = append(rows, []string{"── Tomorrow ──", "", "", "", "", ""})
-			addedSeparator = true
-		}
-		rows = append(rows, []string{
-			dep.BookedDepartureTime,
-			dep.Leaving,
-			dep.DeparturePlatform,
-			dep.Platform,
-			truncate(dep.Service, 20),
-			dep.Duration,
-		})
+	via, avoid := labelStyle, labelStyle
+	if m.viaInput.Focused() {
+		via = activeStyle
+	} else {
+		avoid = activeStyle
 	}
 
-	t := table.New().
-		Border(lipgloss.NormalBorder()).
-		BorderStyle(lipgloss.NewStyle().Foreground(theme.Border)).
-		Headers("Time", "Leaving", "Dep Plat", "Arr Plat", "Service", "Duration").
-		Rows(rows...).
-		StyleFunc(func(row, col int) lipgloss.Style {
-			if row == -1 {
-				return lipgloss.NewStyle().
-					Foreground(theme.Muted).
-					Bold(true).
-					Align(lipgloss.Left)
-			}
-			if row >= 0 && row < len(rows) && rows[row][0] == "── Tomorrow ──" {
-				return lipgloss.NewStyle().
-					Foreground(theme.Muted).
-					Bold(true).
-					Align(lipgloss.Left)
-			}
-			base := lipgloss.NewStyle().Align(lipgloss.Left)
-			switch col {
-			case 0: // Time
-				return base.Foreground(theme.Time).Bold(true)
-			case 1: // Leaving
-				return base.Foreground(theme.Leaving)
-			case 2: // Dep Platform
-				return base.Foreground(theme.DepPlatform)
-			case 3: // Arr Platform
-				return base.Foreground(theme.ArrPlatform)
-			case 4: // Service
-				return base.Foreground(theme.Service)
-			case 5: // Duration
-				return base.Foreground(theme.Duration)
-			default:
-				return base
-			}
-		})
+	view := titleStyle.Render("Which way should the train go?") + "\n\n" +
+		labelStyle.Render("From: ") + m.fromStation.name + "\n" +
+		labelStyle.Render("To:   ") + m.toStation.name + "\n\n" +
+		via.Render("Via") + "\n" + m.viaInput.View() + "\n\n" +
+		avoid.Render("Avoiding") + "\n" + m.avoidInput.View() + "\n\n"
+
+	if m.routeErr != nil {
+		view += lipgloss.NewStyle().Foreground(theme.Error).Render(m.routeErr.Error()) + "\n\n"
+	}
 
-	return t.String()
+	return view + labelStyle.Render("station codes separated by commas, blank for any route • tab switch field • enter search • esc back")
 }
 
-func truncate(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
+// unknownStation returns the first code that is not a known station.
+func unknownStation(codes []string) (string, bool) {
+	for _, code := range codes {
+		if s, ok := stations.ByCode(code); !ok || stations.IsGroup(s.Code) {
+			return code, true
+		}
 	}
-	return s[:maxLen-3] + "..."
+	return "", false
 }
@@ -0,0 +1,247 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/table"
+)
+
+// serviceView shows the full stopping pattern of a single service, opened by
+// pressing Enter on a results row.
+type serviceView struct {
+	apiClient *api.Client
+	serviceID string
+	label     string // e.g. "14:30 Avanti West Coast", shown while loading
+	detail    *api.ServiceDetail
+	req       requestScope
+	spinner   spinner.Model
+	viewport  viewport.Model
+	loading   bool
+	err       error
+}
+
+type serviceDetailMsg struct {
+	serviceID string
+	detail    *api.ServiceDetail
+	err       error
+}
+
+// closeServiceMsg asks the parent model to return to the results table.
+type closeServiceMsg struct{}
+
+func newServiceView(apiClient *api.Client, serviceID, label string, width, height int) serviceView {
+	s := spinner.New(
+		spinner.WithSpinner(spinner.Points),
+		spinner.WithStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("205"))),
+	)
+	sv := serviceView{
+		apiClient: apiClient,
+		serviceID: serviceID,
+		label:     label,
+		spinner:   s,
+		loading:   true,
+	}
+	sv.SetSize(width, height)
+	sv.req.next()
+	return sv
+}
+
+func (s serviceView) Init() tea.Cmd {
+	return tea.Batch(s.spinner.Tick, s.fetchDetail(s.req.ctx))
+}
+
+func (s serviceView) fetchDetail(ctx context.Context) tea.Cmd {
+	serviceID := s.serviceID
+	return func() tea.Msg {
+		detail, err := s.apiClient.GetServiceDetail(ctx, serviceID)
+		return serviceDetailMsg{serviceID: serviceID, detail: detail, err: err}
+	}
+}
+
+// SetSize resizes the viewport to fit a screen of the given dimensions.
+func (s *serviceView) SetSize(width, height int) {
+	headerHeight := 3 // title + blank line
+	footerHeight := 3 // blank line + footer + blank line
+	s.viewport = viewport.New(viewport.WithWidth(width), viewport.WithHeight(height-headerHeight-footerHeight))
+	s.viewport.SetContent(s.renderCallingPoints())
+}
+
+func (s serviceView) Update(msg tea.Msg) (serviceView, tea.Cmd) {
+	switch msg := msg.(type) {
+	case serviceDetailMsg:
+		// Drop results for a service that was closed before it loaded
+		if msg.serviceID != s.serviceID || isCancelled(msg.err) {
+			return s, nil
+		}
+		s.loading = false
+		s.detail = msg.detail
+		s.err = msg.err
+		s.viewport.SetContent(s.renderCallingPoints())
+		return s, nil
+
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "esc", "backspace", "left", "h":
+			s.req.stop()
+			return s, func() tea.Msg { return closeServiceMsg{} }
+		case "j":
+			s.viewport.ScrollDown(1)
+			return s, nil
+		case "k":
+			s.viewport.ScrollUp(1)
+			return s, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	if s.loading {
+		s.spinner, cmd = s.spinner.Update(msg)
+		return s, cmd
+	}
+	s.viewport, cmd = s.viewport.Update(msg)
+	return s, cmd
+}
+
+func (s serviceView) View() string {
+	theme := CurrentTheme()
+
+	if s.loading {
+		style := lipgloss.NewStyle().
+			Foreground(theme.Title).
+			Bold(true).
+			Padding(1, 0)
+		return style.Render(fmt.Sprintf("%s Loading calling points for the %s service...",
+			s.spinner.View(), s.label)) + renderThrottle(s.apiClient.LimiterStatus())
+	}
+
+	if s.err != nil {
+		errorStyle := lipgloss.NewStyle().
+			Foreground(theme.Error).
+			Bold(true).
+			Padding(1, 0)
+		return errorStyle.Render(fmt.Sprintf("%s\n\nPress esc to go back", errorMessage(s.err)))
+	}
+
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Title).Bold(true)
+	origin, destination := s.detail.Origin(), s.detail.Destination()
+	title := titleStyle.Render(fmt.Sprintf("%s %s to %s (%s)",
+		formatClock(origin.BookedDeparture), origin.Name, destination.Name, s.detail.Operator))
+
+	footerStyle := lipgloss.NewStyle().Foreground(theme.Muted)
+	scrollInfo := fmt.Sprintf("%d%%", int(s.viewport.ScrollPercent()*100))
+	footer := footerStyle.Render(fmt.Sprintf("↑/↓ scroll • %s • esc back", scrollInfo))
+
+	return title + "\n\n" + s.viewport.View() + "\n\n" + footer + "\n"
+}
+
+func (s serviceView) renderCallingPoints() string {
+	if s.detail == nil {
+		return ""
+	}
+	theme := CurrentTheme()
+
+	position, atStation := s.detail.Position()
+
+	rows := [][]string{}
+	markers := []int{} // row index -> calling point index, or -1 for the in-transit marker
+	for i, cp := range s.detail.CallingPoints {
+		marker := ""
+		if i == position && atStation {
+			marker = "▶"
+		}
+		rows = append(rows, []string{
+			marker,
+			cp.Name,
+			cp.Platform,
+			formatClock(cp.BookedArrival),
+			formatClock(cp.BookedDeparture),
+			callingPointStatus(cp),
+		})
+		markers = append(markers, i)
+
+		if i == position && !atStation && i < len(s.detail.CallingPoints)-1 {
+			rows = append(rows, []string{"↓", "In transit", "", "", "", ""})
+			markers = append(markers, -1)
+		}
+	}
+
+	t := table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(theme.Border)).
+		Headers("", "Station", "Plat", "Arr", "Dep", "Status").
+		Rows(rows...).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			base := lipgloss.NewStyle().Align(lipgloss.Left)
+			if row == -1 {
+				return base.Foreground(theme.Muted).Bold(true)
+			}
+			idx := markers[row]
+			if idx == -1 {
+				return base.Foreground(theme.Leaving).Bold(true)
+			}
+			cp := s.detail.CallingPoints[idx]
+			if cp.Cancelled {
+				return base.Foreground(theme.Muted).Strikethrough(true)
+			}
+			if idx < position || (idx == position && !atStation) {
+				return base.Foreground(theme.Muted)
+			}
+			switch col {
+			case 0:
+				return base.Foreground(theme.Leaving).Bold(true)
+			case 1:
+				return base.Foreground(theme.Time).Bold(idx == position)
+			case 2:
+				return base.Foreground(theme.DepPlatform)
+			case 5:
+				if rows[row][5] == "On time" {
+					return base.Foreground(theme.OnTime)
+				}
+				return base.Foreground(theme.Late)
+			default:
+				return base
+			}
+		})
+
+	return t.String()
+}
+
+// callingPointStatus summarises what has happened, or is expected to happen,
+// at a calling point.
+func callingPointStatus(cp api.CallingPoint) string {
+	switch {
+	case cp.Cancelled:
+		return "Cancelled"
+	case !cp.ActualDeparture.IsZero():
+		return "Departed " + formatClock(cp.ActualDeparture)
+	case !cp.ActualArrival.IsZero():
+		return "Arrived " + formatClock(cp.ActualArrival)
+	case !cp.ExpectedDeparture.IsZero():
+		return expectedStatus(cp.BookedDeparture, cp.ExpectedDeparture)
+	case !cp.ExpectedArrival.IsZero():
+		return expectedStatus(cp.BookedArrival, cp.ExpectedArrival)
+	}
+	return ""
+}
+
+func expectedStatus(booked, expected time.Time) string {
+	if booked.IsZero() || expected.Sub(booked).Round(time.Minute) == 0 {
+		return "On time"
+	}
+	return "Exp " + formatClock(expected)
+}
+
+// formatClock formats a time as HH:MM, or an empty string if it is unknown.
+func formatClock(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("15:04")
+}
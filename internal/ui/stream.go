@@ -0,0 +1,71 @@
+package ui
+
+import (
+	"context"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+	tea "charm.land/bubbletea/v2"
+)
+
+const (
+	// streamPageSize is how many more services are loaded at a time as the
+	// results are scrolled.
+	streamPageSize = 10
+
+	// prefetchRows is how close the cursor gets to the last loaded row before
+	// more are loaded.
+	prefetchRows = 5
+)
+
+// streamStartedMsg reports that a search has found its services and started
+// loading their details.
+type streamStartedMsg struct {
+	stream *api.DepartureStream
+	err    error
+}
+
+// streamEventMsg carries the next result of a streaming search.
+type streamEventMsg struct {
+	stream *api.DepartureStream
+	event  api.DepartureEvent
+	done   bool // the stream has finished; its Err says whether it stopped early
+}
+
+// streamRefreshMsg carries a search repeated to refresh the results, loaded
+// as far as the previous one had been.
+type streamRefreshMsg struct {
+	stream *api.DepartureStream
+	result api.DepartureResult
+	loaded int // services already reported by the stream
+	err    error
+}
+
+// startStream starts a search whose results are shown as they load.
+func startStream(ctx context.Context, client *api.Client, from, to string, search api.SearchOptions) tea.Cmd {
+	return func() tea.Msg {
+		stream, err := client.StreamDepartures(ctx, from, to, search)
+		return streamStartedMsg{stream: stream, err: err}
+	}
+}
+
+// refreshStream repeats a search, waiting for the first n services so the
+// results can be replaced in one go and changes highlighted.
+func refreshStream(ctx context.Context, client *api.Client, from, to string, search api.SearchOptions, n int) tea.Cmd {
+	return func() tea.Msg {
+		stream, err := client.StreamDepartures(ctx, from, to, search)
+		if err != nil {
+			return streamRefreshMsg{err: err}
+		}
+		n = min(n, stream.Total())
+		result, err := stream.Collect(n)
+		return streamRefreshMsg{stream: stream, result: result, loaded: n, err: err}
+	}
+}
+
+// waitForEvent waits for the next result of a streaming search.
+func waitForEvent(stream *api.DepartureStream) tea.Cmd {
+	return func() tea.Msg {
+		ev, ok := <-stream.Events()
+		return streamEventMsg{stream: stream, event: ev, done: !ok}
+	}
+}
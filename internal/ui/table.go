@@ -0,0 +1,209 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+	"github.com/baz-sh/rtt-cli/internal/output"
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/table"
+)
+
+// DisplayOptions controls how departure results are presented.
+type DisplayOptions struct {
+	HideCancelled bool
+	Columns       []output.Column // nil shows the default columns for the search
+	WatchInterval time.Duration   // refresh results this often; zero disables watch mode
+}
+
+// columns returns the table columns to display for a search.
+func (o DisplayOptions) columns(from, to string, search api.SearchOptions) []output.Column {
+	if len(o.Columns) == 0 {
+		return output.DefaultColumns(from, to, search)
+	}
+	return o.Columns
+}
+
+// visibleDepartures applies the display options to a list of departures.
+func visibleDepartures(departures []api.Departure, opts DisplayOptions) []api.Departure {
+	if !opts.HideCancelled {
+		return departures
+	}
+	var visible []api.Departure
+	for _, dep := range departures {
+		if !dep.Cancelled {
+			visible = append(visible, dep)
+		}
+	}
+	return visible
+}
+
+// nextDayLabel returns the separator shown above the first departure on a later day.
+func nextDayLabel(t time.Time) string {
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	if t.Year() == tomorrow.Year() && t.YearDay() == tomorrow.YearDay() {
+		return "── Tomorrow ──"
+	}
+	return "── " + t.Format("Mon 2 Jan") + " ──"
+}
+
+// cancelledToggleLabel describes what the cancelled-services key will do.
+func cancelledToggleLabel(opts DisplayOptions) string {
+	if opts.HideCancelled {
+		return "show cancelled"
+	}
+	return "hide cancelled"
+}
+
+// tableHeaderLines is the number of lines above the first row of a table:
+// the top border, the header and the header separator.
+const tableHeaderLines = 3
+
+// renderDepartureTable renders departures as a bordered table, shared by the
+// quick display and the interactive selector. Departures whose service ID is in
+// changed are highlighted, as is the departure at index cursor; the returned
+// line is where the cursor row was rendered.
+func renderDepartureTable(departures []api.Departure, cols []output.Column, changed map[string]bool, cursor int) (string, int) {
+	theme := CurrentTheme()
+
+	headers := make([]string, len(cols))
+	for i, col := range cols {
+		headers[i] = col.Header
+	}
+
+	rows := [][]string{}
+	cancelled := []bool{}
+	changedRows := map[int]bool{}
+	separatorRow := -1
+	selectedRow := -1
+	for i, dep := range departures {
+		if dep.NextDay && separatorRow == -1 {
+			separatorRow = len(rows)
+			separator := make([]string, len(cols))
+			separator[0] = nextDayLabel(dep.ScheduledDeparture)
+			rows = append(rows, separator)
+			cancelled = append(cancelled, false)
+		}
+		if i == cursor {
+			selectedRow = len(rows)
+		}
+		cancelled = append(cancelled, dep.Cancelled)
+		if changed[dep.ServiceID] {
+			changedRows[len(rows)] = true
+		}
+
+		row := make([]string, len(cols))
+		for j, col := range cols {
+			row[j] = col.Value(dep)
+			switch col.Key {
+			case "service":
+				row[j] = truncate(row[j], 20)
+			case "calls-at":
+				row[j] = truncate(row[j], 30)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	t := table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(theme.Border)).
+		Headers(headers...).
+		Rows(rows...).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == -1 {
+				return lipgloss.NewStyle().
+					Foreground(theme.Muted).
+					Bold(true).
+					Align(lipgloss.Left)
+			}
+			if row == separatorRow {
+				return lipgloss.NewStyle().
+					Foreground(theme.Muted).
+					Bold(true).
+					Align(lipgloss.Left)
+			}
+			base := lipgloss.NewStyle().Align(lipgloss.Left)
+			key := cols[col].Key
+			if row == selectedRow {
+				base = base.Background(theme.Selected)
+			}
+			if changedRows[row] && (key == "expected" || key == "dep-plat") && !cancelled[row] {
+				return base.Foreground(theme.Changed).Bold(true).Reverse(true)
+			}
+			if cancelled[row] {
+				if key == "expected" {
+					return base.Foreground(theme.Error).Bold(true)
+				}
+				return base.Foreground(theme.Muted).Strikethrough(true)
+			}
+			switch key {
+			case "time":
+				return base.Foreground(theme.Time).Bold(true)
+			case "expected":
+				if rows[row][col] == "On time" {
+					return base.Foreground(theme.OnTime)
+				}
+				return base.Foreground(theme.Late)
+			case "leaving":
+				return base.Foreground(theme.Leaving)
+			case "dep-plat":
+				return base.Foreground(theme.DepPlatform)
+			case "arr-plat":
+				return base.Foreground(theme.ArrPlatform)
+			case "service":
+				return base.Foreground(theme.Service)
+			case "duration":
+				return base.Foreground(theme.Duration)
+			default:
+				return base
+			}
+		})
+
+	return t.String() + renderCancellationNotes(departures), selectedRow + tableHeaderLines
+}
+
+// renderCancellationNotes lists the published reasons for cancelled departures.
+func renderCancellationNotes(departures []api.Departure) string {
+	theme := CurrentTheme()
+	style := lipgloss.NewStyle().Foreground(theme.Error)
+
+	notes := ""
+	for _, dep := range departures {
+		if dep.Cancelled && dep.CancellationReason != "" {
+			notes += "\n" + style.Render(fmt.Sprintf("✕ %s %s: %s",
+				dep.BookedDepartureTime, dep.Service, dep.CancellationReason))
+		}
+	}
+	return notes
+}
+
+// renderSkippedNotes lists services that could not be loaded, one per line.
+func renderSkippedNotes(skipped []api.ServiceError) string {
+	theme := CurrentTheme()
+	style := lipgloss.NewStyle().Foreground(theme.Late)
+
+	notes := ""
+	for _, s := range skipped {
+		notes += "\n" + style.Render(fmt.Sprintf("? %s %s: %s",
+			formatClock(s.ScheduledDeparture), s.Operator, errorMessage(s.Err)))
+	}
+	return notes
+}
+
+// skippedSummary says how many services are missing from a list, e.g.
+// "3 services could not be loaded".
+func skippedSummary(n int) string {
+	if n == 1 {
+		return "1 service could not be loaded"
+	}
+	return fmt.Sprintf("%d services could not be loaded", n)
+}
+
+func truncate(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+	return s[:maxLen-3] + "..."
+}
@@ -18,6 +18,10 @@ type Theme struct {
 	ArrPlatform color.Color
 	Service     color.Color
 	Duration    color.Color
+	OnTime      color.Color
+	Late        color.Color
+	Selected    color.Color
+	Changed     color.Color
 }
 
 // Dark theme - bright/saturated colors for dark terminal backgrounds.
@@ -32,6 +36,10 @@ var darkTheme = Theme{
 	ArrPlatform: lipgloss.Color("46"),  // bright green
 	Service:     lipgloss.Color("201"), // bright magenta
 	Duration:    lipgloss.Color("141"), // lavender
+	OnTime:      lipgloss.Color("46"),  // bright green
+	Late:        lipgloss.Color("214"), // orange
+	Selected:    lipgloss.Color("237"), // charcoal
+	Changed:     lipgloss.Color("226"), // yellow
 }
 
 // Light theme - deeper/darker colors for light terminal backgrounds.
@@ -46,6 +54,10 @@ var lightTheme = Theme{
 	ArrPlatform: lipgloss.Color("28"),  // dark green
 	Service:     lipgloss.Color("90"),  // dark magenta
 	Duration:    lipgloss.Color("61"),  // dark lavender/slate blue
+	OnTime:      lipgloss.Color("28"),  // dark green
+	Late:        lipgloss.Color("166"), // dark orange
+	Selected:    lipgloss.Color("254"), // pale gray
+	Changed:     lipgloss.Color("136"), // dark yellow
 }
 
 // currentTheme defaults to dark, updated when Bubble Tea detects background color.
@@ -1,20 +1,64 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/baz-sh/rtt-cli/internal/api"
 	"github.com/baz-sh/rtt-cli/internal/config"
+	"github.com/baz-sh/rtt-cli/internal/journey"
+	"github.com/baz-sh/rtt-cli/internal/output"
+	"github.com/baz-sh/rtt-cli/internal/recording"
 	"github.com/baz-sh/rtt-cli/internal/stations"
 	"github.com/baz-sh/rtt-cli/internal/ui"
 	tea "charm.land/bubbletea/v2"
 )
 
+// Exit codes, documented in the README so scripts can branch on them.
+const (
+	exitOK             = 0
+	exitError          = 1 // unexpected failure, e.g. missing credentials
+	exitUsage          = 2 // invalid flags or arguments
+	exitNoTrains       = 3 // the search succeeded but found no trains
+	exitUnknownStation = 4
+	exitAPIError       = 5
+)
+
+type cliOptions struct {
+	reset         bool
+	hideCancelled bool
+	at            string
+	arriveBy      string
+	format        string
+	columns       string
+	watch         bool
+	interval      time.Duration
+	minChange     time.Duration
+	maxChanges    int
+	via           string
+	avoid         string
+	record        string
+	replay        string
+	noCache       bool
+	strict        bool
+	limit         int
+	boards        int
+}
+
 func main() {
+	opts, args, err := parseArgs(os.Args[1:])
+	if err == flag.ErrHelp {
+		return
+	}
+	if err != nil {
+		os.Exit(exitUsage)
+	}
+
 	// Handle --reset flag
-	if len(os.Args) == 2 && os.Args[1] == "--reset" {
+	if opts.reset {
 		if err := config.Reset(); err != nil {
 			fmt.Printf("Error resetting credentials: %v\n", err)
 			os.Exit(1)
@@ -24,47 +68,269 @@ func main() {
 		return
 	}
 
-	// Load or prompt for credentials
-	cfg, err := loadOrPromptCredentials()
+	// Cache maintenance: rtt-cli cache clear|stats
+	if len(args) >= 1 && args[0] == "cache" {
+		if len(args) != 2 {
+			fmt.Fprintln(os.Stderr, "Usage: rtt-cli cache clear|stats")
+			os.Exit(exitUsage)
+		}
+		os.Exit(runCache(args[1]))
+	}
+
+	if opts.record != "" && opts.replay != "" {
+		fmt.Fprintln(os.Stderr, "Error: --record and --replay cannot be used together")
+		os.Exit(exitUsage)
+	}
+
+	// A replayed session runs as of the time it was recorded
+	var replay *recording.Replayer
+	now := time.Now()
+	if opts.replay != "" {
+		replay, err = recording.OpenReplay(opts.replay)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(exitError)
+		}
+		now = replay.Now()
+	}
+
+	search, err := searchOptions(opts, now)
 	if err != nil {
-		fmt.Printf("Error: %v\n", err)
-		os.Exit(1)
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(exitUsage)
+	}
+
+	format, err := outputFormat(opts.format)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(exitUsage)
+	}
+
+	columns, err := output.ParseColumns(opts.columns)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(exitUsage)
+	}
+
+	if err := validateWatch(opts, format); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(exitUsage)
+	}
+
+	// Load or prompt for credentials. Replays don't talk to the API, so they
+	// need none.
+	cfg := &config.Config{}
+	if replay == nil {
+		cfg, err = loadOrPromptCredentials()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(exitError)
+		}
+	} else if saved, err := config.Load(); err == nil && saved != nil {
+		cfg.Groups = saved.Groups
+	}
+
+	// Offer the user's station groups alongside the built-in ones
+	for _, g := range cfg.Groups {
+		group := stations.Group{Code: g.Code, Name: g.Name, Members: g.Stations}
+		if err := stations.AddGroup(group); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(exitError)
+		}
+	}
+
+	if err := cfg.ApplyEnv(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(exitError)
+	}
+	clientOpts, err := clientOptions(cfg, opts.record, replay)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(exitError)
+	}
+
+	// Cache service details between runs, unless traffic is being recorded or
+	// replayed, which must see every request. The cache is only an
+	// optimisation, so carry on without it if it can't be opened.
+	if !opts.noCache && opts.record == "" && replay == nil {
+		if cache, err := openCache(); err == nil {
+			clientOpts = append(clientOpts, api.WithCache(cache))
+		}
 	}
 
 	// Create API client with credentials
-	client := api.NewClient(cfg.Token)
+	clientOpts = append(clientOpts, api.WithStationGroups(stationGroups()))
+	client := api.NewClient(cfg.Token, clientOpts...)
 
-	// Check for command-line arguments: rtt-cli FROM TO
-	if len(os.Args) == 3 {
-		fromCode := strings.ToUpper(os.Args[1])
-		toCode := strings.ToUpper(os.Args[2])
+	displayOpts := ui.DisplayOptions{HideCancelled: opts.hideCancelled, Columns: columns}
+	if opts.watch {
+		displayOpts.WatchInterval = opts.interval
+	}
 
-		// Validate station codes
-		fromStation := findStation(fromCode)
-		toStation := findStation(toCode)
+	// Station boards: rtt-cli board CODE / rtt-cli arrivals CODE
+	if len(args) == 2 && (args[0] == "board" || args[0] == "arrivals") {
+		if opts.format != "" && format != formatTUI {
+			fmt.Fprintln(os.Stderr, "Error: station boards are only available in the interactive view")
+			os.Exit(exitUsage)
+		}
+		direction := api.Departures
+		if args[0] == "arrivals" {
+			direction = api.Arrivals
+		}
+		runBoard(client, resolveSingleStation(args[1], opts.strict), direction)
+		return
+	}
 
-		if fromStation == nil {
-			fmt.Printf("Error: Unknown station code '%s'\n", fromCode)
-			os.Exit(1)
+	// Nearest stations: rtt-cli near PLACE, where an unquoted place may be
+	// several words
+	if len(args) >= 2 && args[0] == "near" {
+		if format != formatTUI && format != formatJSON && format != output.FormatText {
+			fmt.Fprintln(os.Stderr, "Error: nearby stations can only be listed as text or json")
+			os.Exit(exitUsage)
 		}
-		if toStation == nil {
-			fmt.Printf("Error: Unknown station code '%s'\n", toCode)
-			os.Exit(1)
+		if opts.limit < 1 || opts.boards < 0 {
+			fmt.Fprintln(os.Stderr, "Error: --limit must be at least 1 and --boards at least 0")
+			os.Exit(exitUsage)
+		}
+		os.Exit(runNear(client, strings.Join(args[1:], " "), opts.limit, opts.boards, format))
+	}
+
+	// Journey planner: rtt-cli plan FROM TO
+	if len(args) == 3 && args[0] == "plan" {
+		if opts.format != "" && format != formatTUI {
+			fmt.Fprintln(os.Stderr, "Error: journey plans are only available in the interactive view")
+			os.Exit(exitUsage)
+		}
+		if search.ArriveBy {
+			fmt.Fprintln(os.Stderr, "Error: --arrive-by is not supported by the journey planner, use --at")
+			os.Exit(exitUsage)
+		}
+		if len(search.Via) > 0 || len(search.Avoid) > 0 {
+			fmt.Fprintln(os.Stderr, "Error: --via and --avoid are not supported by the journey planner")
+			os.Exit(exitUsage)
+		}
+		planOpts := journey.Options{At: search.At, MinConnection: opts.minChange, MaxChanges: opts.maxChanges}
+		runPlan(client, resolveSingleStation(args[1], opts.strict), resolveSingleStation(args[2], opts.strict), planOpts)
+		return
+	}
+
+	// Check for command-line arguments: rtt-cli FROM TO
+	if len(args) == 2 {
+		fromStation := resolveStation(args[0], opts.strict)
+		toStation := resolveStation(args[1], opts.strict)
+		search.Via = resolveStationCodes(stationList(opts.via, opts.strict), opts.strict)
+		search.Avoid = resolveStationCodes(stationList(opts.avoid, opts.strict), opts.strict)
+
+		if format != formatTUI {
+			os.Exit(printDepartures(client, fromStation, toStation, search, format, columns, opts.hideCancelled))
 		}
 
 		// Quick mode - fetch and display directly
-		runQuickMode(client, fromCode, toCode, fromStation.Name, toStation.Name)
+		runQuickMode(client, fromStation.Code, toStation.Code, fromStation.Name, toStation.Name, search, displayOpts)
 		return
 	}
 
+	if len(args) != 0 {
+		fmt.Fprintln(os.Stderr, "Usage: rtt-cli [flags] [FROM TO | plan FROM TO | board CODE | arrivals CODE | near PLACE | cache clear|stats]")
+		os.Exit(exitUsage)
+	}
+
 	// Interactive mode
-	p := tea.NewProgram(ui.NewSelectorModel(client))
+	p := tea.NewProgram(ui.NewSelectorModel(client, displayOpts))
 	if _, err := p.Run(); err != nil {
 		fmt.Printf("Error: %v\n", err)
 		os.Exit(1)
 	}
 }
 
+// parseArgs parses command-line flags, which may appear before, between or
+// after the positional station arguments.
+func parseArgs(args []string) (cliOptions, []string, error) {
+	var opts cliOptions
+	fs := flag.NewFlagSet("rtt-cli", flag.ContinueOnError)
+	fs.BoolVar(&opts.reset, "reset", false, "forget the saved API token")
+	fs.BoolVar(&opts.hideCancelled, "hide-cancelled", false, "hide cancelled services from the results")
+	fs.StringVar(&opts.at, "at", "", "search for trains departing from `TIME` (HH:MM or YYYY-MM-DDTHH:MM)")
+	fs.StringVar(&opts.format, "format", "", "output `FORMAT` for quick lookups: tui, json, text, csv, tsv or markdown (default tui, or json when stdout is not a terminal)")
+	fs.BoolVar(&opts.watch, "watch", false, "keep the results open and refresh them automatically")
+	fs.DurationVar(&opts.interval, "interval", time.Minute, "how often to refresh in watch mode")
+	fs.StringVar(&opts.columns, "columns", "", "comma-separated `LIST` of table columns: time, expected, leaving, dep-plat (plat), arr-plat, service, duration, calls-at")
+	fs.StringVar(&opts.arriveBy, "arrive-by", "", "search for trains arriving by `TIME` (HH:MM or YYYY-MM-DDTHH:MM)")
+	fs.StringVar(&opts.via, "via", "", "only show trains calling at these `STATIONS` (comma-separated codes or names) on the way")
+	fs.StringVar(&opts.avoid, "avoid", "", "hide trains calling at any of these `STATIONS` (comma-separated codes or names) on the way")
+	fs.DurationVar(&opts.minChange, "min-change", journey.DefaultMinConnection, "shortest change of trains the journey planner may suggest")
+	fs.IntVar(&opts.maxChanges, "max-changes", 2, "most changes of train the journey planner may suggest (0-2)")
+	fs.StringVar(&opts.record, "record", "", "save every API request and response to `DIR`, with the token redacted")
+	fs.StringVar(&opts.replay, "replay", "", "answer API requests from a session recorded in `DIR` instead of the network")
+	fs.BoolVar(&opts.noCache, "no-cache", false, "download every service's details again instead of using cached copies")
+	fs.BoolVar(&opts.strict, "strict", false, "only accept exact station codes, never names or fuzzy matches")
+	fs.IntVar(&opts.limit, "limit", 5, "how many stations near lists")
+	fs.IntVar(&opts.boards, "boards", 0, "preview the next departures from the first `N` stations near lists")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: rtt-cli [flags] [FROM TO | plan FROM TO | board CODE | arrivals CODE | near PLACE | cache clear|stats]")
+		fs.PrintDefaults()
+	}
+
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return opts, nil, err
+		}
+		if fs.NArg() == 0 {
+			return opts, positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// searchOptions builds the search from the --at, --arrive-by, --via and
+// --avoid flags. Times without a date are taken relative to now.
+func searchOptions(opts cliOptions, now time.Time) (api.SearchOptions, error) {
+	if opts.at != "" && opts.arriveBy != "" {
+		return api.SearchOptions{}, fmt.Errorf("--at and --arrive-by cannot be used together")
+	}
+
+	search := api.SearchOptions{
+		Via:   api.ParseStationCodes(opts.via),
+		Avoid: api.ParseStationCodes(opts.avoid),
+	}
+
+	if opts.arriveBy != "" {
+		at, err := api.ParseSearchTime(opts.arriveBy, now)
+		if err != nil {
+			return api.SearchOptions{}, err
+		}
+		search.At, search.ArriveBy = at, true
+		return search, nil
+	}
+
+	at, err := api.ParseSearchTime(opts.at, now)
+	if err != nil {
+		return api.SearchOptions{}, err
+	}
+	search.At = at
+	return search, nil
+}
+
+// validateWatch checks that --watch is combined with options that make sense for
+// a live board.
+func validateWatch(opts cliOptions, format string) error {
+	if !opts.watch {
+		return nil
+	}
+	if format != formatTUI {
+		return fmt.Errorf("--watch is only supported in the interactive view")
+	}
+	if opts.at != "" || opts.arriveBy != "" {
+		return fmt.Errorf("--watch cannot be combined with --at or --arrive-by")
+	}
+	if opts.interval < 10*time.Second {
+		return fmt.Errorf("--interval must be at least 10s")
+	}
+	return nil
+}
+
 func loadOrPromptCredentials() (*config.Config, error) {
 	cfg, err := config.Load()
 	if err != nil {
@@ -82,17 +348,30 @@ func loadOrPromptCredentials() (*config.Config, error) {
 	return cfg, nil
 }
 
-func findStation(code string) *stations.Station {
-	for _, s := range stations.Stations {
-		if s.Code == code {
-			return &s
-		}
+func runQuickMode(client *api.Client, fromCode, toCode, fromName, toName string, search api.SearchOptions, opts ui.DisplayOptions) {
+	m := ui.NewQuickDisplayModel(client, fromCode, toCode, fromName, toName, search, opts)
+	p := tea.NewProgram(m)
+	if _, err := p.Run(); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runBoard(client *api.Client, station stations.Station, direction api.Direction) {
+	p := tea.NewProgram(ui.NewBoardModel(client, station.Code, station.Name, direction))
+	if _, err := p.Run(); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
 	}
-	return nil
 }
 
-func runQuickMode(client *api.Client, fromCode, toCode, fromName, toName string) {
-	m := ui.NewQuickDisplayModel(client, fromCode, toCode, fromName, toName)
+func runPlan(client *api.Client, from, to stations.Station, opts journey.Options) {
+	if opts.MaxChanges < 0 || opts.MaxChanges > 2 {
+		fmt.Fprintln(os.Stderr, "Error: --max-changes must be between 0 and 2")
+		os.Exit(exitUsage)
+	}
+
+	m := ui.NewJourneyModel(journey.NewPlanner(client), from.Code, to.Code, from.Name, to.Name, opts)
 	p := tea.NewProgram(m)
 	if _, err := p.Run(); err != nil {
 		fmt.Printf("Error: %v\n", err)
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"text/tabwriter"
+	"time"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+	"github.com/baz-sh/rtt-cli/internal/output"
+	"github.com/baz-sh/rtt-cli/internal/stations"
+)
+
+// boardPreviewSize is how many departures are previewed for each station
+// listed by near --boards.
+const boardPreviewSize = 3
+
+// nearbyStation is a station listed by near, with its departures if they were
+// previewed.
+type nearbyStation struct {
+	stations.Nearby
+	previewed  bool
+	departures []api.BoardEntry
+	err        error // why the departures could not be loaded
+}
+
+// runNear lists the stations closest to a place, and previews the next
+// departures from the first boards of them. It returns the process exit code.
+func runNear(client *api.Client, place string, limit, boards int, format string) int {
+	// A place that could be one of several stations is resolved the same way
+	// as the stations of a search, asking the user which they meant
+	lat, lon, err := stations.Locate(place)
+	var ambiguous *stations.AmbiguousError
+	if errors.As(err, &ambiguous) {
+		lat, lon, err = stations.Locate(resolveStation(place, false).Code)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitUnknownStation
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	code := exitOK
+	var nearby []nearbyStation
+	for i, n := range stations.Near(lat, lon, limit) {
+		s := nearbyStation{Nearby: n}
+		if i < boards {
+			result, err := client.GetBoard(ctx, n.Station.Code, api.Departures)
+			s.previewed, s.err = true, err
+			s.departures = result.Entries[:min(boardPreviewSize, len(result.Entries))]
+			if err != nil {
+				code = exitAPIError
+			}
+		}
+		nearby = append(nearby, s)
+	}
+
+	if format == formatJSON {
+		err = writeNearJSON(place, lat, lon, nearby)
+	} else {
+		err = writeNearText(place, lat, lon, nearby)
+		if located, total := stations.Coverage(); located < total {
+			fmt.Fprintf(os.Stderr, "Only the %d of %d stations whose locations are known are searched.\n",
+				located, total)
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitError
+	}
+	return code
+}
+
+func writeNearText(place string, lat, lon float64, nearby []nearbyStation) error {
+	fmt.Printf("Stations near %s (%.4f, %.4f)\n\n", place, lat, lon)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, n := range nearby {
+		fmt.Fprintf(w, "%.1f km\t%s\t%s\n", n.DistanceKm, n.Station.Name, n.Station.Code)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	for _, n := range nearby {
+		if !n.previewed {
+			continue
+		}
+		fmt.Printf("\nDepartures from %s\n", n.Station.Name)
+		if n.err != nil {
+			fmt.Printf("  Could not load departures: %v\n", n.err)
+			continue
+		}
+		if len(n.departures) == 0 {
+			fmt.Println("  No departures in the next two hours")
+			continue
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, dep := range n.departures {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", dep.ScheduledTime.Format("15:04"), dep.Destination,
+				platformLabel(dep.Platform), output.BoardStatus(dep))
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nearDocument is the JSON written by near --format json.
+type nearDocument struct {
+	Place    string        `json:"place"`
+	Lat      float64       `json:"lat"`
+	Lon      float64       `json:"lon"`
+	Coverage nearCoverage  `json:"coverage"`
+	Stations []nearbyEntry `json:"stations"`
+}
+
+// nearCoverage says how many stations could be searched. Only those whose
+// locations are known are, so closer stations may be missing when partial.
+type nearCoverage struct {
+	Partial bool `json:"partial"`
+	Located int  `json:"located"`
+	Total   int  `json:"total"`
+}
+
+type nearbyEntry struct {
+	output.Station
+	DistanceKm float64           `json:"distance_km"`
+	Departures []nearbyDeparture `json:"departures,omitzero"` // nil unless previewed
+	Error      string            `json:"error,omitempty"`
+}
+
+type nearbyDeparture struct {
+	ServiceID          string    `json:"service_id"`
+	Destination        string    `json:"destination"`
+	Operator           string    `json:"operator"`
+	ScheduledDeparture time.Time `json:"scheduled_departure"`
+	ExpectedDeparture  time.Time `json:"expected_departure,omitzero"`
+	Platform           string    `json:"platform,omitempty"`
+	Cancelled          bool      `json:"cancelled"`
+}
+
+func writeNearJSON(place string, lat, lon float64, nearby []nearbyStation) error {
+	located, total := stations.Coverage()
+	doc := nearDocument{
+		Place:    place,
+		Lat:      lat,
+		Lon:      lon,
+		Coverage: nearCoverage{Partial: located < total, Located: located, Total: total},
+		Stations: make([]nearbyEntry, 0, len(nearby)),
+	}
+	for _, n := range nearby {
+		entry := nearbyEntry{
+			Station:    output.Station{Code: n.Station.Code, Name: n.Station.Name},
+			DistanceKm: float64(int(n.DistanceKm*100+0.5)) / 100,
+		}
+		if n.err != nil {
+			entry.Error = n.err.Error()
+		} else if n.previewed {
+			entry.Departures = []nearbyDeparture{}
+		}
+		for _, dep := range n.departures {
+			entry.Departures = append(entry.Departures, nearbyDeparture{
+				ServiceID:          dep.ServiceID,
+				Destination:        dep.Destination,
+				Operator:           dep.Operator,
+				ScheduledDeparture: dep.ScheduledTime,
+				ExpectedDeparture:  dep.ExpectedTime,
+				Platform:           dep.Platform,
+				Cancelled:          dep.Cancelled,
+			})
+		}
+		doc.Stations = append(doc.Stations, entry)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+func platformLabel(platform string) string {
+	if platform == "" {
+		return ""
+	}
+	return "plat " + platform
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+	"github.com/baz-sh/rtt-cli/internal/output"
+	"github.com/baz-sh/rtt-cli/internal/stations"
+	"golang.org/x/term"
+)
+
+const (
+	formatTUI  = "tui"
+	formatJSON = "json"
+)
+
+// outputFormat validates the --format flag. When no format is given, the
+// full-screen UI is used on a terminal and JSON everywhere else.
+func outputFormat(format string) (string, error) {
+	switch format {
+	case "":
+		if term.IsTerminal(int(os.Stdout.Fd())) {
+			return formatTUI, nil
+		}
+		return formatJSON, nil
+	case formatTUI, formatJSON, output.FormatText, output.FormatCSV, output.FormatTSV, output.FormatMarkdown:
+		return format, nil
+	}
+	return "", fmt.Errorf("unknown format %q: use tui, json, text, csv, tsv or markdown", format)
+}
+
+// printDepartures fetches departures and writes them to stdout in a
+// machine-readable format. It returns the process exit code. Interrupting the
+// program cancels the search.
+func printDepartures(client *api.Client, from, to stations.Station, search api.SearchOptions, format string, cols []output.Column, hideCancelled bool) int {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	result, err := client.GetDepartures(ctx, from.Code, to.Code, search)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		if errors.Is(err, api.ErrUnauthorized) {
+			fmt.Fprintln(os.Stderr, "Your token was rejected. Run rtt-cli --reset to enter a new one.")
+		}
+		return exitAPIError
+	}
+
+	if hideCancelled {
+		var running []api.Departure
+		for _, dep := range result.Departures {
+			if !dep.Cancelled {
+				running = append(running, dep)
+			}
+		}
+		result.Departures = running
+	}
+
+	switch format {
+	case formatJSON:
+		err = output.WriteJSON(os.Stdout,
+			output.Station{Code: from.Code, Name: from.Name},
+			output.Station{Code: to.Code, Name: to.Name},
+			result)
+	default:
+		if cols == nil {
+			cols = output.DefaultColumns(from.Code, to.Code, search)
+		}
+		err = output.WriteTable(os.Stdout, format, cols, result.Departures)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitError
+	}
+
+	// Warn that the list may be incomplete, without disturbing the output itself
+	if len(result.Skipped) > 0 {
+		fmt.Fprintf(os.Stderr, "Warning: %d services could not be loaded and are missing from the results:\n", len(result.Skipped))
+		for _, skipped := range result.Skipped {
+			fmt.Fprintf(os.Stderr, "  %v\n", skipped)
+		}
+	}
+
+	if len(result.Departures) == 0 {
+		return exitNoTrains
+	}
+	return exitOK
+}
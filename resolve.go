@@ -0,0 +1,100 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/baz-sh/rtt-cli/internal/api"
+	"github.com/baz-sh/rtt-cli/internal/stations"
+	"github.com/baz-sh/rtt-cli/internal/ui"
+	tea "charm.land/bubbletea/v2"
+	"golang.org/x/term"
+)
+
+// resolveStation finds the station a command-line argument refers to, exiting
+// if there is none. With strict set only exact CRS codes are accepted;
+// otherwise the argument may also be a name or a fuzzy query. If it matches
+// several stations, the user is asked which on a terminal, and elsewhere the
+// likely ones are listed.
+func resolveStation(query string, strict bool) stations.Station {
+	if strict {
+		station, ok := stations.ByCode(query)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Error: Unknown station code '%s'\n", strings.ToUpper(query))
+			os.Exit(exitUnknownStation)
+		}
+		return station
+	}
+
+	station, err := stations.Resolve(query)
+	if err == nil {
+		return station
+	}
+
+	var ambiguous *stations.AmbiguousError
+	if !errors.As(err, &ambiguous) {
+		fmt.Fprintf(os.Stderr, "Error: No station matches '%s'\n", query)
+		os.Exit(exitUnknownStation)
+	}
+
+	if term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd())) {
+		m, err := tea.NewProgram(ui.NewStationPickerModel(query, ambiguous.Matches)).Run()
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(exitError)
+		}
+		if station, ok := m.(ui.StationPickerModel).Selected(); ok {
+			return station
+		}
+		os.Exit(exitUnknownStation)
+	}
+
+	fmt.Fprintf(os.Stderr, "Error: '%s' matches more than one station. Did you mean:\n", query)
+	for _, match := range ambiguous.Matches {
+		fmt.Fprintf(os.Stderr, "  %s (%s)\n", match.Station.Name, match.Station.Code)
+	}
+	os.Exit(exitUnknownStation)
+	return stations.Station{}
+}
+
+// resolveSingleStation is resolveStation for arguments that must name a
+// single station rather than a station group.
+func resolveSingleStation(query string, strict bool) stations.Station {
+	station := resolveStation(query, strict)
+	if stations.IsGroup(station.Code) {
+		fmt.Fprintf(os.Stderr, "Error: %s is a station group, which can only be searched from or to\n", station.Name)
+		os.Exit(exitUsage)
+	}
+	return station
+}
+
+// resolveStationCodes resolves each of a list of station arguments, such as
+// those given to --via, and returns their CRS codes. Stations named more than
+// once, e.g. by name and by code, are only listed once.
+func resolveStationCodes(queries []string, strict bool) []string {
+	var codes []string
+	for _, query := range queries {
+		if code := resolveSingleStation(query, strict).Code; !slices.Contains(codes, code) {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+// stationList splits a --via or --avoid list. Names may contain spaces, so
+// only codes, in strict mode, may also be separated by spaces.
+func stationList(s string, strict bool) []string {
+	if strict {
+		return api.ParseStationCodes(s)
+	}
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}